@@ -0,0 +1,272 @@
+package gsorm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
+)
+
+// association is the relation between the model and the associated model,
+// which is declared by the field tag like `gsorm:"hasmany=Salaries,fk=emp_no"`.
+type association struct {
+	// Index of the field which stores the associated models.
+	field int
+
+	// Type of the associated struct.
+	typ reflect.Type
+
+	tag *internal.Tag
+}
+
+// keyColumns returns the column of the model and the column of the associated model which relate them.
+// For hasone, hasmany and many2many, fk is the column of the associated model (or the join table)
+// and references is the column of the model.
+// For belongsto, fk is the column of the model and references is the column of the associated model.
+// If references is empty, it's same as fk.
+func (a *association) keyColumns() (string, string) {
+	refs := a.tag.References
+	if refs == "" {
+		refs = a.tag.FK
+	}
+	switch a.tag.Relation {
+	case "belongsto":
+		return a.tag.FK, refs
+	case "many2many":
+		return refs, a.tag.JoinRef
+	}
+	return refs, a.tag.FK
+}
+
+// associationOf returns the association of the struct whose name is the given name.
+func associationOf(typ reflect.Type, name string) (*association, error) {
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := internal.ExtractTag(f)
+		if !tag.Lookup("association") || tag.Association != name {
+			continue
+		}
+		if tag.FK == "" {
+			return nil, xerrors.Errorf("fk is required for association %s", name)
+		}
+		if tag.Relation == "many2many" && !tag.Lookup("join") {
+			return nil, xerrors.Errorf("join is required for association %s", name)
+		}
+
+		at := f.Type
+		switch tag.Relation {
+		case "hasmany", "many2many":
+			if at.Kind() != reflect.Slice {
+				return nil, xerrors.Errorf("%s is invalid type for %s association", at.String(), tag.Relation)
+			}
+			at = at.Elem()
+		}
+		if at.Kind() == reflect.Ptr {
+			at = at.Elem()
+		}
+		if at.Kind() != reflect.Struct {
+			return nil, xerrors.Errorf("%s is invalid type for %s association", f.Type.String(), tag.Relation)
+		}
+		return &association{field: i, typ: at, tag: tag}, nil
+	}
+	return nil, xerrors.Errorf("association %s is not found in %s", name, typ.String())
+}
+
+// preload loads the associated models of the model by one batched query for each association,
+// and sets them to the fields of the model.
+// The many2many association is loaded through the join table, so it's loaded by two queries.
+// If unscoped is true, the soft deleted associated models are also loaded.
+func preload(conn conn, model interface{}, names []string, unscoped bool) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	mv := reflect.ValueOf(model)
+	if mv.Kind() != reflect.Ptr {
+		return xerrors.New("model must be a pointer")
+	}
+	for mv.Kind() == reflect.Ptr {
+		if mv.IsNil() {
+			return nil
+		}
+		mv = mv.Elem()
+	}
+
+	var parents []reflect.Value
+	typ := mv.Type()
+	switch {
+	case mv.Kind() == reflect.Struct:
+		parents = append(parents, mv)
+	case (mv.Kind() == reflect.Slice || mv.Kind() == reflect.Array) && typ.Elem().Kind() == reflect.Struct:
+		typ = typ.Elem()
+		for i := 0; i < mv.Len(); i++ {
+			parents = append(parents, mv.Index(i))
+		}
+	case (mv.Kind() == reflect.Slice || mv.Kind() == reflect.Array) &&
+		typ.Elem().Kind() == reflect.Ptr && typ.Elem().Elem().Kind() == reflect.Struct:
+		typ = typ.Elem().Elem()
+		for i := 0; i < mv.Len(); i++ {
+			if !mv.Index(i).IsNil() {
+				parents = append(parents, mv.Index(i).Elem())
+			}
+		}
+	default:
+		return xerrors.Errorf("%s is invalid type for Preload", mv.Type().String())
+	}
+
+	for _, name := range names {
+		a, err := associationOf(typ, name)
+		if err != nil {
+			return err
+		}
+		if err := a.load(conn, parents, unscoped); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load loads the associated models of the parents.
+func (a *association) load(conn conn, parents []reflect.Value, unscoped bool) error {
+	if len(parents) == 0 {
+		return nil
+	}
+
+	keyColumn, refColumn := a.keyColumns()
+	key, err := fieldOfColumn(parents[0].Type(), keyColumn)
+	if err != nil {
+		return err
+	}
+	var keys []interface{}
+	seen := make(map[string]bool)
+	for _, p := range parents {
+		k := fieldValueByIndex(p, key).Interface()
+		if seen[keyString(k)] {
+			continue
+		}
+		seen[keyString(k)] = true
+		keys = append(keys, k)
+	}
+
+	// refs maps the key of the parent to the keys of the associated models.
+	refs := make(map[string][]string)
+	if a.tag.Relation == "many2many" {
+		if keys, err = a.loadJoinTable(conn, keys, refs); err != nil {
+			return err
+		}
+	}
+
+	associated := reflect.New(reflect.SliceOf(a.typ))
+	if len(keys) > 0 {
+		table, err := From(conn, associated.Interface())
+		if err != nil {
+			return err
+		}
+		stmt := newSelectStmt(conn).Columns(associated.Interface())
+		if unscoped {
+			stmt = stmt.Unscoped()
+		}
+		if err := stmt.From(table).
+			Where(fmt.Sprintf("%s IN (?)", refColumn), keys).
+			Query(associated.Interface()); err != nil {
+			return err
+		}
+	}
+
+	ref, err := fieldOfColumn(a.typ, refColumn)
+	if err != nil {
+		return err
+	}
+	// found maps the key of the associated model to the associated models.
+	found := make(map[string][]reflect.Value)
+	for i := 0; i < associated.Elem().Len(); i++ {
+		v := associated.Elem().Index(i)
+		k := keyString(fieldValueByIndex(v, ref).Interface())
+		found[k] = append(found[k], v)
+	}
+
+	for _, p := range parents {
+		k := keyString(fieldValueByIndex(p, key).Interface())
+		var values []reflect.Value
+		if a.tag.Relation == "many2many" {
+			for _, r := range refs[k] {
+				values = append(values, found[r]...)
+			}
+		} else {
+			values = found[k]
+		}
+		a.set(p.Field(a.field), values)
+	}
+	return nil
+}
+
+// loadJoinTable selects the rows of the join table of many2many association whose parent keys are included in keys.
+// It stores the keys of the associated models for each parent key into refs, and returns the distinct keys of them.
+func (a *association) loadJoinTable(conn conn, keys []interface{}, refs map[string][]string) ([]interface{}, error) {
+	var rows []map[string]interface{}
+	stmt := newSelectStmt(conn, a.tag.FK, a.tag.JoinRef).
+		From(a.tag.JoinTable).
+		Where(fmt.Sprintf("%s IN (?)", a.tag.FK), keys)
+	if err := stmt.Query(&rows); err != nil {
+		return nil, err
+	}
+
+	var refKeys []interface{}
+	seen := make(map[string]bool)
+	for _, r := range rows {
+		k, ref := keyString(r[a.tag.FK]), keyString(r[a.tag.JoinRef])
+		refs[k] = append(refs[k], ref)
+		if !seen[ref] {
+			seen[ref] = true
+			refKeys = append(refKeys, r[a.tag.JoinRef])
+		}
+	}
+	return refKeys, nil
+}
+
+// set sets the associated models to the field.
+// If the association is hasone or belongsto, the first one is set.
+func (a *association) set(field reflect.Value, values []reflect.Value) {
+	switch a.tag.Relation {
+	case "hasmany", "many2many":
+		sl := reflect.MakeSlice(field.Type(), 0, len(values))
+		for _, v := range values {
+			if field.Type().Elem().Kind() == reflect.Ptr {
+				ptr := reflect.New(a.typ)
+				ptr.Elem().Set(v)
+				v = ptr
+			}
+			sl = reflect.Append(sl, v)
+		}
+		field.Set(sl)
+	default:
+		if len(values) == 0 {
+			field.Set(reflect.Zero(field.Type()))
+			return
+		}
+		if field.Kind() == reflect.Ptr {
+			v := reflect.New(a.typ)
+			v.Elem().Set(values[0])
+			field.Set(v)
+			return
+		}
+		field.Set(values[0])
+	}
+}
+
+// fieldOfColumn returns the index sequence of the field of the struct which is mapped to the column.
+func fieldOfColumn(typ reflect.Type, column string) ([]int, error) {
+	if index := matchField(structFields(typ, "", "", nil), column); index != nil {
+		return index, nil
+	}
+	return nil, xerrors.Errorf("%s doesn't have the field of column %s", typ.String(), column)
+}
+
+// keyString converts the key to string so that the keys scanned as the different types can be compared.
+func keyString(k interface{}) string {
+	if b, ok := k.([]byte); ok {
+		return string(b)
+	}
+	return fmt.Sprint(k)
+}
@@ -0,0 +1,266 @@
+package gsorm_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/champon1020/gsorm"
+	"gotest.tools/v3/assert"
+)
+
+type Department struct {
+	DeptNo   string
+	DeptName string
+}
+
+type assocEmployee struct {
+	EmpNo       int
+	FirstName   string
+	Salaries    []Salary     `gsorm:"hasmany=Salaries,fk=emp_no"`
+	Title       *Title       `gsorm:"hasone=Title,fk=emp_no"`
+	Departments []Department `gsorm:"many2many=Departments,fk=emp_no,join=dept_emp:dept_no"`
+}
+
+func (e *assocEmployee) TableName() string {
+	return "employees"
+}
+
+type assocSalary struct {
+	EmpNo    int
+	Salary   int
+	Employee assocEmployee `gsorm:"belongsto=Employee,fk=emp_no"`
+}
+
+func (s *assocSalary) TableName() string {
+	return "salaries"
+}
+
+type assocDeptManager struct {
+	DeptNo    string
+	ManagerNo int
+	Manager   *assocEmployee `gsorm:"belongsto=Manager,fk=manager_no,references=emp_no"`
+}
+
+func (m *assocDeptManager) TableName() string {
+	return "dept_manager"
+}
+
+func newEmployeeRows(values [][]interface{}) gsorm.ExportedIRows {
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}
+	return newFakeRows(ct, values)
+}
+
+func TestSelectStmt_Preload(t *testing.T) {
+	salaryRows := newFakeRows([]gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("salary", reflect.TypeOf(0)),
+	}, [][]interface{}{{1001, 60000}, {1002, 70000}, {1001, 65000}})
+	titleRows := newFakeRows([]gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("title", reflect.TypeOf("")),
+		newFakeColumn("deleted_at", reflect.TypeOf(&time.Time{})),
+	}, [][]interface{}{{1002, "Engineer", nil}})
+	db := newFakeDBWithRows(
+		newEmployeeRows([][]interface{}{{1001, "Taro"}, {1002, "Jiro"}, {1003, "Saburo"}}),
+		salaryRows,
+		titleRows,
+	).(*fakeDB)
+
+	var employees []assocEmployee
+	err := gsorm.SelectModel(db, &employees).Preload("Salaries", "Title").From("employees").Query(&employees)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+
+	assert.DeepEqual(t, []string{
+		"SELECT emp_no, first_name FROM employees",
+		"SELECT emp_no, salary FROM salaries WHERE emp_no IN (1001, 1002, 1003)",
+		"SELECT emp_no, title, deleted_at FROM titles WHERE deleted_at IS NULL AND (emp_no IN (1001, 1002, 1003))",
+	}, db.queries)
+	assert.DeepEqual(t, []assocEmployee{
+		{
+			EmpNo:     1001,
+			FirstName: "Taro",
+			Salaries:  []Salary{{EmpNo: 1001, Salary: 60000}, {EmpNo: 1001, Salary: 65000}},
+		},
+		{
+			EmpNo:     1002,
+			FirstName: "Jiro",
+			Salaries:  []Salary{{EmpNo: 1002, Salary: 70000}},
+			Title:     &Title{EmpNo: 1002, Title: "Engineer"},
+		},
+		{
+			EmpNo:     1003,
+			FirstName: "Saburo",
+			Salaries:  []Salary{},
+		},
+	}, employees)
+}
+
+func TestSelectStmt_Preload_BelongsTo(t *testing.T) {
+	db := newFakeDBWithRows(
+		newFakeRows([]gsorm.ExportedIColumnType{
+			newFakeColumn("emp_no", reflect.TypeOf(0)),
+			newFakeColumn("salary", reflect.TypeOf(0)),
+		}, [][]interface{}{{1001, 60000}}),
+		newEmployeeRows([][]interface{}{{1001, "Taro"}}),
+	).(*fakeDB)
+
+	var salary assocSalary
+	err := gsorm.SelectModel(db, &salary).Preload("Employee").From("salaries").First(&salary)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+
+	assert.DeepEqual(t, []string{
+		"SELECT emp_no, salary FROM salaries LIMIT 1",
+		"SELECT emp_no, first_name FROM employees WHERE emp_no IN (1001)",
+	}, db.queries)
+	assert.DeepEqual(t, assocSalary{
+		EmpNo:    1001,
+		Salary:   60000,
+		Employee: assocEmployee{EmpNo: 1001, FirstName: "Taro"},
+	}, salary)
+}
+
+func TestSelectStmt_Preload_BelongsTo_References(t *testing.T) {
+	db := newFakeDBWithRows(
+		newFakeRows([]gsorm.ExportedIColumnType{
+			newFakeColumn("dept_no", reflect.TypeOf("")),
+			newFakeColumn("manager_no", reflect.TypeOf(0)),
+		}, [][]interface{}{{"d001", 1001}, {"d002", 1002}}),
+		newEmployeeRows([][]interface{}{{1002, "Jiro"}, {1001, "Taro"}}),
+	).(*fakeDB)
+
+	var managers []assocDeptManager
+	err := gsorm.SelectModel(db, &managers).Preload("Manager").From("dept_manager").Query(&managers)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+
+	assert.DeepEqual(t, []string{
+		"SELECT dept_no, manager_no FROM dept_manager",
+		"SELECT emp_no, first_name FROM employees WHERE emp_no IN (1001, 1002)",
+	}, db.queries)
+	assert.DeepEqual(t, []assocDeptManager{
+		{DeptNo: "d001", ManagerNo: 1001, Manager: &assocEmployee{EmpNo: 1001, FirstName: "Taro"}},
+		{DeptNo: "d002", ManagerNo: 1002, Manager: &assocEmployee{EmpNo: 1002, FirstName: "Jiro"}},
+	}, managers)
+}
+
+func TestSelectStmt_Preload_Unscoped(t *testing.T) {
+	db := newFakeDBWithRows(
+		newEmployeeRows([][]interface{}{{1001, "Taro"}}),
+		newFakeRows([]gsorm.ExportedIColumnType{
+			newFakeColumn("emp_no", reflect.TypeOf(0)),
+			newFakeColumn("title", reflect.TypeOf("")),
+		}, [][]interface{}{{1001, "Engineer"}}),
+	).(*fakeDB)
+
+	var employees []assocEmployee
+	err := gsorm.SelectModel(db, &employees).Unscoped().Preload("Title").From("employees").Query(&employees)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+
+	assert.DeepEqual(t, []string{
+		"SELECT emp_no, first_name FROM employees",
+		"SELECT emp_no, title, deleted_at FROM titles WHERE emp_no IN (1001)",
+	}, db.queries)
+	assert.DeepEqual(t, &Title{EmpNo: 1001, Title: "Engineer"}, employees[0].Title)
+}
+
+func TestSelectStmt_Preload_Many2Many(t *testing.T) {
+	db := newFakeDBWithRows(
+		newEmployeeRows([][]interface{}{{1001, "Taro"}, {1002, "Jiro"}}),
+		newFakeRows([]gsorm.ExportedIColumnType{
+			newFakeColumn("emp_no", reflect.TypeOf(0)),
+			newFakeColumn("dept_no", reflect.TypeOf("")),
+		}, [][]interface{}{{1001, "d001"}, {1001, "d002"}, {1002, "d001"}}),
+		newFakeRows([]gsorm.ExportedIColumnType{
+			newFakeColumn("dept_no", reflect.TypeOf("")),
+			newFakeColumn("dept_name", reflect.TypeOf("")),
+		}, [][]interface{}{{"d001", "Marketing"}, {"d002", "Finance"}}),
+	).(*fakeDB)
+
+	var employees []assocEmployee
+	err := gsorm.SelectModel(db, &employees).Preload("Departments").From("employees").Query(&employees)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+
+	assert.DeepEqual(t, []string{
+		"SELECT emp_no, first_name FROM employees",
+		"SELECT emp_no, dept_no FROM dept_emp WHERE emp_no IN (1001, 1002)",
+		"SELECT dept_no, dept_name FROM departments WHERE dept_no IN ('d001', 'd002')",
+	}, db.queries)
+	assert.DeepEqual(t, []assocEmployee{
+		{
+			EmpNo:       1001,
+			FirstName:   "Taro",
+			Departments: []Department{{DeptNo: "d001", DeptName: "Marketing"}, {DeptNo: "d002", DeptName: "Finance"}},
+		},
+		{
+			EmpNo:       1002,
+			FirstName:   "Jiro",
+			Departments: []Department{{DeptNo: "d001", DeptName: "Marketing"}},
+		},
+	}, employees)
+}
+
+func TestSelectStmt_Preload_Mock(t *testing.T) {
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.SelectModel(nil, &assocEmployee{}).From("employees"),
+		[]assocEmployee{{EmpNo: 1001, FirstName: "Taro"}})
+	mock.ExpectWithReturn(gsorm.SelectModel(nil, &Salary{}).From("salaries").Where("emp_no IN (?)", []interface{}{1001}),
+		[]Salary{{EmpNo: 1001, Salary: 60000}})
+
+	var employees []assocEmployee
+	err := gsorm.SelectModel(mock, &employees).Preload("Salaries").From("employees").Query(&employees)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []Salary{{EmpNo: 1001, Salary: 60000}}, employees[0].Salaries)
+}
+
+func TestSelectStmt_Preload_Fail(t *testing.T) {
+	testCases := []struct {
+		Model         interface{}
+		Association   string
+		ExpectedError string
+	}{
+		{
+			&[]assocEmployee{},
+			"Dept",
+			"association Dept is not found in gsorm_test.assocEmployee",
+		},
+		{
+			&map[string]interface{}{},
+			"Salaries",
+			"map[string]interface {} is invalid type for Preload",
+		},
+	}
+
+	for _, testCase := range testCases {
+		db := newFakeDB(newEmployeeRows([][]interface{}{}))
+		err := gsorm.Select(db).Preload(testCase.Association).From("employees").Query(testCase.Model)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
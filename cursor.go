@@ -0,0 +1,163 @@
+package gsorm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// cursorTimeLayout is the layout of the time value of cursor which is written into SQL.
+// Unlike internal.ToString, the fractional seconds are kept.
+const cursorTimeLayout = "2006-01-02 15:04:05.999999999"
+
+// EncodeCursor encodes the values of the ordered columns into the opaque cursor token.
+// The token is decoded by (*SelectStmt).SeekAfter.
+//
+// The types of values must be integer, float, bool, string or time.Time.
+func EncodeCursor(values ...interface{}) (string, error) {
+	items := make([]string, len(values))
+	for i, v := range values {
+		item, err := encodeCursorValue(v)
+		if err != nil {
+			return "", err
+		}
+		items[i] = item
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// NextCursor encodes the values of the columns of the last element of model into the cursor token.
+// model must be a pointer of slice of struct or map. If model is empty, NextCursor returns empty string.
+func NextCursor(model interface{}, columns ...string) (string, error) {
+	mv := reflect.ValueOf(model)
+	if mv.Kind() != reflect.Ptr {
+		return "", xerrors.New("model must be a pointer")
+	}
+	mv = mv.Elem()
+	if mv.Kind() != reflect.Slice && mv.Kind() != reflect.Array {
+		return "", xerrors.New("model must be a pointer of slice or array")
+	}
+	if mv.Len() == 0 {
+		return "", nil
+	}
+
+	last := reflect.Indirect(mv.Index(mv.Len() - 1))
+	values := make([]interface{}, len(columns))
+	for i, c := range columns {
+		v, err := cursorValueOf(last, c)
+		if err != nil {
+			return "", err
+		}
+		values[i] = v
+	}
+	return EncodeCursor(values...)
+}
+
+// cursorValueOf returns the value of column in the item which is struct or map.
+func cursorValueOf(item reflect.Value, column string) (interface{}, error) {
+	// Table name like "e.emp_no" is removed.
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		column = column[i+1:]
+	}
+
+	switch item.Kind() {
+	case reflect.Struct:
+		if index := matchField(structFields(item.Type(), "", "", nil), column); index != nil {
+			return fieldValueByIndex(item, index).Interface(), nil
+		}
+	case reflect.Map:
+		if v := item.MapIndex(reflect.ValueOf(column)); v.IsValid() {
+			return v.Interface(), nil
+		}
+	default:
+		return nil, xerrors.Errorf("%s is invalid type for cursor", item.Kind().String())
+	}
+	return nil, xerrors.Errorf("column %s is not found in model", column)
+}
+
+// encodeCursorValue encodes the value with its type like "i:1001".
+func encodeCursorValue(v interface{}) (string, error) {
+	if t, ok := v.(time.Time); ok {
+		return "t:" + t.Format(time.RFC3339Nano), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "i:" + strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "u:" + strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return "f:" + strconv.FormatFloat(rv.Float(), 'g', -1, 64), nil
+	case reflect.Bool:
+		return "b:" + strconv.FormatBool(rv.Bool()), nil
+	case reflect.String:
+		return "s:" + rv.String(), nil
+	case reflect.Invalid:
+		return "", xerrors.New("value of cursor must not be nil")
+	}
+	return "", xerrors.Errorf("%s is invalid type for cursor", rv.Type().String())
+}
+
+// decodeCursor decodes the cursor token into the values.
+func decodeCursor(cursor string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, xerrors.New("cursor is invalid")
+	}
+	var items []string
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, xerrors.New("cursor is invalid")
+	}
+
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		v, err := decodeCursorValue(item)
+		if err != nil {
+			return nil, xerrors.New("cursor is invalid")
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// decodeCursorValue decodes the value which is encoded by encodeCursorValue.
+func decodeCursorValue(item string) (interface{}, error) {
+	strs := strings.SplitN(item, ":", 2)
+	if len(strs) != 2 {
+		return nil, xerrors.Errorf("%s is invalid value of cursor", item)
+	}
+
+	switch strs[0] {
+	case "t":
+		return time.Parse(time.RFC3339Nano, strs[1])
+	case "i":
+		return strconv.ParseInt(strs[1], 10, 64)
+	case "u":
+		return strconv.ParseUint(strs[1], 10, 64)
+	case "f":
+		return strconv.ParseFloat(strs[1], 64)
+	case "b":
+		return strconv.ParseBool(strs[1])
+	case "s":
+		return strs[1], nil
+	}
+	return nil, xerrors.Errorf("%s is invalid value of cursor", item)
+}
+
+// escapeString escapes the string literal so that the value decoded from cursor can be embedded into SQL safely.
+func escapeString(s string, d Dialect) string {
+	if d.IsMySQL() {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return strings.ReplaceAll(s, "'", "''")
+}
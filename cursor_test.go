@@ -0,0 +1,109 @@
+package gsorm_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/champon1020/gsorm"
+	"gotest.tools/v3/assert"
+)
+
+func TestNextCursor(t *testing.T) {
+	type Employee struct {
+		EmpNo    int
+		LastName string
+		HireDate time.Time
+	}
+	hireDate := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	model := []Employee{
+		{EmpNo: 1001, LastName: "Suzuki", HireDate: hireDate},
+		{EmpNo: 1002, LastName: "Yamada", HireDate: hireDate},
+	}
+
+	cursor, err := gsorm.NextCursor(&model, "e.hire_date", "emp_no")
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	expected, _ := gsorm.EncodeCursor(hireDate, 1002)
+	assert.Equal(t, expected, cursor)
+
+	actual := gsorm.Select(nil).From("employees AS e").
+		OrderBy("e.hire_date", "emp_no").
+		SeekAfter(cursor).(*gsorm.SelectStmt).SQL()
+	assert.Equal(t, "SELECT * FROM employees AS e "+
+		"WHERE e.hire_date > '2006-01-02 15:04:05' OR (e.hire_date = '2006-01-02 15:04:05' AND emp_no > 1002) "+
+		"ORDER BY e.hire_date, emp_no", actual)
+}
+
+func TestNextCursor_FractionalSeconds(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		CreatedAt time.Time
+	}
+	createdAt := time.Date(2021, time.April, 1, 9, 0, 0, 123456000, time.UTC)
+	model := []Employee{{EmpNo: 1001, CreatedAt: createdAt}}
+
+	cursor, err := gsorm.NextCursor(&model, "created_at")
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	actual := gsorm.Select(nil).From("employees").
+		OrderBy("created_at").
+		SeekAfter(cursor).(*gsorm.SelectStmt).SQL()
+	assert.Equal(t, "SELECT * FROM employees "+
+		"WHERE created_at > '2021-04-01 09:00:00.123456' ORDER BY created_at", actual)
+}
+
+func TestNextCursor_Empty(t *testing.T) {
+	model := []map[string]interface{}{}
+	cursor, err := gsorm.NextCursor(&model, "emp_no")
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, "", cursor)
+}
+
+func TestNextCursor_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo int
+	}
+
+	testCases := []struct {
+		Model         interface{}
+		Columns       []string
+		ExpectedError string
+	}{
+		{
+			[]Employee{{EmpNo: 1001}},
+			[]string{"emp_no"},
+			"model must be a pointer",
+		},
+		{
+			&Employee{EmpNo: 1001},
+			[]string{"emp_no"},
+			"model must be a pointer of slice or array",
+		},
+		{
+			&[]Employee{{EmpNo: 1001}},
+			[]string{"first_name"},
+			"column first_name is not found in model",
+		},
+		{
+			&[]map[string]interface{}{{"emp_no": nil}},
+			[]string{"emp_no"},
+			"value of cursor must not be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := gsorm.NextCursor(testCase.Model, testCase.Columns...)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
@@ -2,6 +2,7 @@ package gsorm
 
 import (
 	"database/sql"
+	"reflect"
 	"time"
 
 	"golang.org/x/xerrors"
@@ -59,10 +60,89 @@ func (r *result) RowsAffected() (int64, error) {
 	return r.result.RowsAffected()
 }
 
+// lastInsertIDRows is the rows which has only one row of LastInsertId.
+// It's used to emulate RETURNING clause on the database which doesn't support it.
+type lastInsertIDRows struct {
+	column string
+	id     int64
+	done   bool
+}
+
+func newLastInsertIDRows(column string, id int64) *lastInsertIDRows {
+	return &lastInsertIDRows{column: column, id: id}
+}
+
+func (r *lastInsertIDRows) Next() bool {
+	if r.done {
+		return false
+	}
+	r.done = true
+	return true
+}
+
+func (r *lastInsertIDRows) Scan(args ...interface{}) error {
+	if len(args) != 1 {
+		return xerrors.Errorf("number of columns must be 1, not %d", len(args))
+	}
+	v := reflect.ValueOf(args[0]).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(r.id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(r.id))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(r.id))
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(r.id))
+	default:
+		return xerrors.Errorf("LastInsertId cannot be scanned into %s", v.Type().String())
+	}
+	return nil
+}
+
+func (r *lastInsertIDRows) Close() error {
+	return nil
+}
+
+func (r *lastInsertIDRows) ColumnTypes() ([]icolumnType, error) {
+	return []icolumnType{&lastInsertIDColumnType{name: r.column}}, nil
+}
+
+type lastInsertIDColumnType struct {
+	name string
+}
+
+func (c *lastInsertIDColumnType) Name() string {
+	return c.name
+}
+
+func (c *lastInsertIDColumnType) ScanType() reflect.Type {
+	return reflect.TypeOf(int64(0))
+}
+
 // db is a database handle representing a pool of zero or more underlying connections.
 // It's safe for concurrent use by multiple goroutines.
 type db struct {
 	conn sqlDB
+	opts options
+}
+
+// dialect returns the SQL dialect of the database.
+func (d *db) dialect() Dialect {
+	if d.opts.dialect == "" {
+		return MySQL
+	}
+	return d.opts.dialect
+}
+
+// clock returns the clock of the database.
+func (d *db) clock() Clock {
+	return d.opts.clock
+}
+
+// namingStrategy returns the naming strategy of the database.
+func (d *db) namingStrategy() NamingStrategy {
+	return d.opts.namingStrategy
 }
 
 // Ping verifies a connection to the database is still alive, establishing a connection if necessary.
@@ -159,6 +239,21 @@ type tx struct {
 	conn sqlTx
 }
 
+// dialect returns the SQL dialect of the database.
+func (t *tx) dialect() Dialect {
+	return dialectOf(t.db)
+}
+
+// clock returns the clock of the database.
+func (t *tx) clock() Clock {
+	return clockOf(t.db)
+}
+
+// namingStrategy returns the naming strategy of the database.
+func (t *tx) namingStrategy() NamingStrategy {
+	return namingStrategyOf(t.db)
+}
+
 // Ping verifies a connection to the database is still alive, establishing a connection if necessary.
 func (t *tx) Ping() error {
 	if t.db == nil {
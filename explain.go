@@ -0,0 +1,377 @@
+package gsorm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/champon1020/gsorm/explain"
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
+)
+
+// explain executes EXPLAIN statement of the SQL which is built by buildSQL and parses the output into the plan.
+func (s *stmt) explain(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt, opts []explain.Option) (*explain.Plan, error) {
+	if len(s.errors) > 0 {
+		return nil, s.errors[0]
+	}
+
+	switch conn := s.conn.(type) {
+	case Mock:
+		returned, err := conn.compareWith(stmt)
+		if err != nil {
+			return nil, err
+		}
+		switch p := returned.(type) {
+		case nil:
+			return &explain.Plan{}, nil
+		case explain.Plan:
+			return &p, nil
+		}
+		return nil, xerrors.Errorf("returned value of %s cannot be mapped to explain.Plan",
+			reflect.TypeOf(returned).String())
+	case DB, Tx:
+		var sql internal.SQL
+		if err := buildSQL(&sql); err != nil {
+			return nil, err
+		}
+
+		d := dialectOf(s.conn)
+		o := explain.NewOptions(opts...)
+		query, err := explainSQL(sql.String(), d, o)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := conn.Query(query)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		records, err := scanRecords(rows)
+		if err != nil {
+			return nil, err
+		}
+		return parsePlan(records, d, o)
+	}
+
+	return nil, xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
+}
+
+// explainSQL returns EXPLAIN statement of the SQL for the dialect.
+func explainSQL(sql string, d Dialect, o explain.Options) (string, error) {
+	switch d {
+	case PostgreSQL:
+		// The output is always requested in JSON format since it's parsed into the plan.
+		if o.Analyze {
+			return fmt.Sprintf("EXPLAIN (ANALYZE, FORMAT JSON) %s", sql), nil
+		}
+		return fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", sql), nil
+	case SQLite:
+		if o.Analyze {
+			return "", xerrors.Errorf("EXPLAIN ANALYZE is not supported by %s", d)
+		}
+		if o.JSON {
+			return "", xerrors.Errorf("FORMAT=JSON is not supported by %s", d)
+		}
+		return fmt.Sprintf("EXPLAIN QUERY PLAN %s", sql), nil
+	}
+
+	if o.Analyze && d == MySQL5 {
+		return "", xerrors.Errorf("EXPLAIN ANALYZE is not supported by %s", d)
+	}
+	if o.Analyze && o.JSON {
+		return "", xerrors.Errorf("EXPLAIN ANALYZE with FORMAT=JSON is not supported by %s", d)
+	}
+	if o.Analyze {
+		return fmt.Sprintf("EXPLAIN ANALYZE %s", sql), nil
+	}
+	if o.JSON {
+		return fmt.Sprintf("EXPLAIN FORMAT=JSON %s", sql), nil
+	}
+	return fmt.Sprintf("EXPLAIN %s", sql), nil
+}
+
+// scanRecords scans all rows into the maps of column name and value.
+func scanRecords(rows irows) ([]map[string]interface{}, error) {
+	ct, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(ct))
+		ptrs := make([]interface{}, len(ct))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+
+		r := make(map[string]interface{}, len(ct))
+		for i, c := range ct {
+			r[c.Name()] = values[i]
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// parsePlan parses the output of EXPLAIN statement for the dialect.
+func parsePlan(records []map[string]interface{}, d Dialect, o explain.Options) (*explain.Plan, error) {
+	switch {
+	case d == PostgreSQL:
+		raw, err := singleOutput(records, "QUERY PLAN")
+		if err != nil {
+			return nil, err
+		}
+		return parsePostgreSQLPlan(raw)
+	case d == SQLite:
+		return parseSQLitePlan(records)
+	case o.Analyze:
+		raw, err := singleOutput(records, "EXPLAIN")
+		if err != nil {
+			return nil, err
+		}
+		return parseMySQLTreePlan(raw), nil
+	case o.JSON:
+		raw, err := singleOutput(records, "EXPLAIN")
+		if err != nil {
+			return nil, err
+		}
+		return parseMySQLJSONPlan(raw)
+	}
+	return parseMySQLTablePlan(records)
+}
+
+// singleOutput returns the value of the column in the first row as string.
+// It's used for the output of EXPLAIN in JSON or tree format which is returned as one value.
+func singleOutput(records []map[string]interface{}, column string) (string, error) {
+	if len(records) == 0 {
+		return "", xerrors.New("EXPLAIN returned no rows")
+	}
+	v, ok := records[0][column]
+	if !ok {
+		return "", xerrors.Errorf("column %s is not found in the output of EXPLAIN", column)
+	}
+	return toStringValue(v)
+}
+
+// parseMySQLTablePlan parses the output of EXPLAIN of MySQL in traditional format.
+// Each row is parsed into the node.
+func parseMySQLTablePlan(records []map[string]interface{}) (*explain.Plan, error) {
+	plan := &explain.Plan{}
+	for _, r := range records {
+		var (
+			n   explain.Node
+			err error
+		)
+		if n.Table, err = toStringValue(r["table"]); err != nil {
+			return nil, err
+		}
+		if n.AccessType, err = toStringValue(r["type"]); err != nil {
+			return nil, err
+		}
+		if n.Key, err = toStringValue(r["key"]); err != nil {
+			return nil, err
+		}
+		if n.Rows, err = toInt64(r["rows"]); err != nil {
+			return nil, err
+		}
+		if n.Detail, err = toStringValue(r["Extra"]); err != nil {
+			return nil, err
+		}
+		plan.Nodes = append(plan.Nodes, n)
+	}
+	return plan, nil
+}
+
+// parseMySQLJSONPlan parses the output of EXPLAIN FORMAT=JSON of MySQL.
+// Each object of "table" is parsed into the node.
+func parseMySQLJSONPlan(raw string) (*explain.Plan, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, xerrors.Errorf("output of EXPLAIN is invalid: %w", err)
+	}
+
+	plan := &explain.Plan{Raw: raw}
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case []interface{}:
+			for _, e := range v {
+				walk(e)
+			}
+		case map[string]interface{}:
+			if t, ok := v["table"].(map[string]interface{}); ok {
+				n := explain.Node{
+					Table:      jsonString(t["table_name"]),
+					AccessType: jsonString(t["access_type"]),
+					Key:        jsonString(t["key"]),
+					Rows:       int64(jsonNumber(t["rows_examined_per_scan"])),
+					Detail:     jsonString(t["attached_condition"]),
+				}
+				if c, ok := t["cost_info"].(map[string]interface{}); ok {
+					n.Cost = jsonNumber(c["prefix_cost"])
+				}
+				plan.Nodes = append(plan.Nodes, n)
+			}
+			// Keys are sorted since the order of map is not deterministic.
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				walk(v[k])
+			}
+		}
+	}
+	walk(v)
+	return plan, nil
+}
+
+var (
+	// mysqlTreeAccess matches the line of the table access like "-> Index lookup on e using idx (...)".
+	mysqlTreeAccess = regexp.MustCompile(`^-> ([^:(]+?) on (\S+)(?: using (\S+))?`)
+
+	// mysqlTreeOperation matches the line of the other operations like "-> Filter: (...)".
+	mysqlTreeOperation = regexp.MustCompile(`^-> ([^:(]+)`)
+
+	// mysqlTreeCost matches the estimated cost and rows like "(cost=1.25 rows=10)".
+	mysqlTreeCost = regexp.MustCompile(`\(cost=([0-9.e+]+) rows=([0-9.e+]+)\)`)
+)
+
+// parseMySQLTreePlan parses the output of EXPLAIN ANALYZE of MySQL which is in tree format.
+// Each line which starts with "->" is parsed into the node.
+func parseMySQLTreePlan(raw string) *explain.Plan {
+	plan := &explain.Plan{Raw: raw}
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "->") {
+			continue
+		}
+
+		n := explain.Node{Detail: strings.TrimPrefix(line, "-> ")}
+		if m := mysqlTreeAccess.FindStringSubmatch(line); m != nil {
+			n.AccessType, n.Table, n.Key = m[1], m[2], m[3]
+		} else if m := mysqlTreeOperation.FindStringSubmatch(line); m != nil {
+			n.AccessType = strings.TrimSpace(m[1])
+		}
+		if m := mysqlTreeCost.FindStringSubmatch(line); m != nil {
+			n.Cost, _ = strconv.ParseFloat(m[1], 64)
+			rows, _ := strconv.ParseFloat(m[2], 64)
+			n.Rows = int64(rows)
+		}
+		plan.Nodes = append(plan.Nodes, n)
+	}
+	return plan
+}
+
+// parsePostgreSQLPlan parses the output of EXPLAIN (FORMAT JSON) of PostgreSQL.
+// Each object of "Plan" and "Plans" is parsed into the node.
+func parsePostgreSQLPlan(raw string) (*explain.Plan, error) {
+	var out []struct {
+		Plan map[string]interface{}
+	}
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		return nil, xerrors.Errorf("output of EXPLAIN is invalid: %w", err)
+	}
+
+	plan := &explain.Plan{Raw: raw}
+	var walk func(p map[string]interface{})
+	walk = func(p map[string]interface{}) {
+		n := explain.Node{
+			Table:      jsonString(p["Alias"]),
+			AccessType: jsonString(p["Node Type"]),
+			Key:        jsonString(p["Index Name"]),
+			Rows:       int64(jsonNumber(p["Plan Rows"])),
+			Cost:       jsonNumber(p["Total Cost"]),
+		}
+		if n.Table == "" {
+			n.Table = jsonString(p["Relation Name"])
+		}
+		for _, k := range []string{"Index Cond", "Filter", "Hash Cond", "Join Filter", "Recheck Cond"} {
+			if c := jsonString(p[k]); c != "" {
+				n.Detail = c
+				break
+			}
+		}
+		plan.Nodes = append(plan.Nodes, n)
+
+		children, _ := p["Plans"].([]interface{})
+		for _, c := range children {
+			if c, ok := c.(map[string]interface{}); ok {
+				walk(c)
+			}
+		}
+	}
+	for _, o := range out {
+		if o.Plan != nil {
+			walk(o.Plan)
+		}
+	}
+	return plan, nil
+}
+
+// sqliteIndex matches the used index like "USING COVERING INDEX idx_name".
+var sqliteIndex = regexp.MustCompile(`USING (?:[A-Z ]*)INDEX (\S+)`)
+
+// parseSQLitePlan parses the output of EXPLAIN QUERY PLAN of SQLite.
+// Each row is parsed into the node from its detail like "SEARCH employees USING INDEX idx (emp_no=?)".
+func parseSQLitePlan(records []map[string]interface{}) (*explain.Plan, error) {
+	plan := &explain.Plan{}
+	for _, r := range records {
+		detail, err := toStringValue(r["detail"])
+		if err != nil {
+			return nil, err
+		}
+
+		n := explain.Node{Detail: detail}
+		words := strings.Fields(detail)
+		if len(words) >= 2 && (words[0] == "SCAN" || words[0] == "SEARCH") {
+			n.AccessType = words[0]
+			// SQLite 3.35 or older outputs like "SCAN TABLE employees".
+			if words[1] == "TABLE" && len(words) >= 3 {
+				words = words[1:]
+			}
+			n.Table = words[1]
+		}
+		if m := sqliteIndex.FindStringSubmatch(detail); m != nil {
+			n.Key = m[1]
+		} else if strings.Contains(detail, "PRIMARY KEY") {
+			n.Key = "PRIMARY KEY"
+		}
+		plan.Nodes = append(plan.Nodes, n)
+	}
+	return plan, nil
+}
+
+// jsonString returns the value of JSON as string.
+func jsonString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// jsonNumber returns the value of JSON as float64.
+// The number like "1.20" which is represented as string is also converted.
+func jsonNumber(v interface{}) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
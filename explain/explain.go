@@ -0,0 +1,105 @@
+// Package explain provides the options and the result of EXPLAIN statement
+// which is executed by (*SelectStmt).Explain, (*UpdateStmt).Explain, (*DeleteStmt).Explain and so on.
+package explain
+
+// Option is the option of EXPLAIN statement.
+type Option func(*Options)
+
+// Options stores the configuration of EXPLAIN statement.
+type Options struct {
+	Analyze bool
+	JSON    bool
+}
+
+// Analyze adds ANALYZE to EXPLAIN statement.
+// Note that the statement is actually executed, so UPDATE or DELETE statement should be explained in transaction.
+func Analyze() Option {
+	return func(o *Options) {
+		o.Analyze = true
+	}
+}
+
+// JSON adds FORMAT=JSON to EXPLAIN statement of MySQL.
+// The output of MySQL in JSON format has the cost of the plan.
+// The output of PostgreSQL is always requested in JSON format since it's parsed into Plan.
+func JSON() Option {
+	return func(o *Options) {
+		o.JSON = true
+	}
+}
+
+// NewOptions creates Options instance.
+func NewOptions(opts ...Option) Options {
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Node is the node of the execution plan.
+// The fields which are not reported by the database are left as zero value.
+type Node struct {
+	// Table is the name or the alias of the accessed table.
+	Table string
+
+	// AccessType is how the table is accessed, like "ALL" and "ref" in MySQL,
+	// "Seq Scan" and "Index Scan" in PostgreSQL, "SCAN" and "SEARCH" in SQLite.
+	AccessType string
+
+	// Key is the name of the used index.
+	Key string
+
+	// Rows is the estimated number of rows.
+	Rows int64
+
+	// Cost is the estimated cost.
+	Cost float64
+
+	// Detail is the extra information of the node.
+	Detail string
+}
+
+// fullScans is the access types which mean the full table scan.
+var fullScans = map[string]bool{
+	"ALL":        true,
+	"Table scan": true,
+	"Seq Scan":   true,
+	"SCAN":       true,
+}
+
+// FullScan reports whether the node scans the whole table without index.
+func (n *Node) FullScan() bool {
+	return fullScans[n.AccessType] && n.Key == ""
+}
+
+// Plan is the execution plan of the statement.
+type Plan struct {
+	// Nodes are the nodes of the plan in depth-first order.
+	Nodes []Node
+
+	// Raw is the output of EXPLAIN statement in JSON or tree format as is.
+	// It's empty if the output is tabular like EXPLAIN of MySQL in traditional format.
+	Raw string
+}
+
+// UsesIndex reports whether any node of the plan uses the index.
+// If index is empty, it reports whether any index is used.
+func (p *Plan) UsesIndex(index string) bool {
+	for _, n := range p.Nodes {
+		if n.Key != "" && (index == "" || n.Key == index) {
+			return true
+		}
+	}
+	return false
+}
+
+// FullScan reports whether any node of the plan scans the whole table without index.
+func (p *Plan) FullScan() bool {
+	for _, n := range p.Nodes {
+		if n.FullScan() {
+			return true
+		}
+	}
+	return false
+}
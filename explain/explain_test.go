@@ -0,0 +1,54 @@
+package explain_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/explain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOptions(t *testing.T) {
+	assert.Equal(t, explain.Options{}, explain.NewOptions())
+	assert.Equal(t, explain.Options{Analyze: true, JSON: true}, explain.NewOptions(explain.Analyze(), explain.JSON()))
+}
+
+func TestPlan_UsesIndex(t *testing.T) {
+	plan := &explain.Plan{Nodes: []explain.Node{
+		{Table: "e", AccessType: "ALL"},
+		{Table: "s", AccessType: "ref", Key: "PRIMARY"},
+	}}
+
+	testCases := []struct {
+		Index    string
+		Expected bool
+	}{
+		{"", true},
+		{"PRIMARY", true},
+		{"idx_salary", false},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.Expected, plan.UsesIndex(testCase.Index))
+	}
+	assert.Equal(t, false, (&explain.Plan{}).UsesIndex(""))
+}
+
+func TestPlan_FullScan(t *testing.T) {
+	testCases := []struct {
+		Node     explain.Node
+		Expected bool
+	}{
+		{explain.Node{AccessType: "ALL"}, true},
+		{explain.Node{AccessType: "Table scan"}, true},
+		{explain.Node{AccessType: "Seq Scan"}, true},
+		{explain.Node{AccessType: "SCAN"}, true},
+		{explain.Node{AccessType: "SCAN", Key: "idx_last_name"}, false},
+		{explain.Node{AccessType: "ref", Key: "PRIMARY"}, false},
+		{explain.Node{AccessType: "Index Scan", Key: "employees_pkey"}, false},
+	}
+
+	for _, testCase := range testCases {
+		plan := &explain.Plan{Nodes: []explain.Node{testCase.Node}}
+		assert.Equal(t, testCase.Expected, plan.FullScan())
+	}
+}
@@ -0,0 +1,299 @@
+package gsorm_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/explain"
+	"github.com/champon1020/gsorm/interfaces"
+	"gotest.tools/v3/assert"
+)
+
+func newExplainDB(d gsorm.Dialect, columns []string, values [][]interface{}) (*fakeDB, gsorm.DB) {
+	ct := make([]gsorm.ExportedIColumnType, len(columns))
+	for i, c := range columns {
+		ct[i] = newFakeColumn(c, reflect.TypeOf([]byte{}))
+	}
+	db := &fakeDB{r: newFakeRows(ct, values)}
+	return db, &gsorm.ExportedDialectDB{DB: db, Dialect: d}
+}
+
+func TestExplain(t *testing.T) {
+	mysqlColumns := []string{
+		"id", "select_type", "table", "partitions", "type", "possible_keys",
+		"key", "key_len", "ref", "rows", "filtered", "Extra",
+	}
+	mysqlJSON := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "1.20"},
+    "nested_loop": [
+      {"table": {"table_name": "e", "access_type": "ALL", "rows_examined_per_scan": 300024,
+        "cost_info": {"prefix_cost": "30.25"}}},
+      {"table": {"table_name": "s", "access_type": "ref", "key": "PRIMARY", "rows_examined_per_scan": 9,
+        "cost_info": {"prefix_cost": "65.50"}, "attached_condition": "(s.salary > 60000)"}}
+    ]
+  }
+}`
+	mysqlTree := "-> Filter: (employees.first_name = 'Georgi')  (cost=0.35 rows=1) (actual time=0.02..0.03 rows=1 loops=1)\n" +
+		"    -> Index lookup on employees using idx_first_name (first_name='Georgi')  (cost=0.35 rows=253) " +
+		"(actual time=0.02..0.03 rows=253 loops=1)\n"
+	pgJSON := `[{"Plan": {"Node Type": "ModifyTable", "Operation": "Update", "Relation Name": "employees",
+  "Alias": "employees", "Startup Cost": 0.29, "Total Cost": 8.31, "Plan Rows": 0,
+  "Plans": [{"Node Type": "Index Scan", "Index Name": "employees_pkey", "Relation Name": "employees",
+    "Alias": "employees", "Startup Cost": 0.29, "Total Cost": 8.31, "Plan Rows": 1,
+    "Index Cond": "(emp_no = 1001)"}]}}]`
+
+	testCases := []struct {
+		Dialect      gsorm.Dialect
+		Stmt         func(db gsorm.DB) interfaces.Explainer
+		Options      []explain.Option
+		Columns      []string
+		Values       [][]interface{}
+		ExpectedSQL  string
+		ExpectedPlan *explain.Plan
+	}{
+		{
+			gsorm.SQLite,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees").Where("last_name = ?", "Suzuki")
+			},
+			nil,
+			[]string{"id", "parent", "notused", "detail"},
+			[][]interface{}{{int64(2), int64(0), int64(0), []byte("SEARCH employees USING INDEX idx_last_name (last_name=?)")}},
+			"EXPLAIN QUERY PLAN SELECT * FROM employees WHERE last_name = 'Suzuki'",
+			&explain.Plan{Nodes: []explain.Node{
+				{
+					Table:      "employees",
+					AccessType: "SEARCH",
+					Key:        "idx_last_name",
+					Detail:     "SEARCH employees USING INDEX idx_last_name (last_name=?)",
+				},
+			}},
+		},
+		{
+			gsorm.SQLite,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Delete(db).From("employees").Where("emp_no = ?", 1001)
+			},
+			nil,
+			[]string{"id", "parent", "notused", "detail"},
+			[][]interface{}{{int64(2), int64(0), int64(0), []byte("SEARCH TABLE employees USING INTEGER PRIMARY KEY (rowid=?)")}},
+			"EXPLAIN QUERY PLAN DELETE FROM employees WHERE emp_no = 1001",
+			&explain.Plan{Nodes: []explain.Node{
+				{
+					Table:      "employees",
+					AccessType: "SEARCH",
+					Key:        "PRIMARY KEY",
+					Detail:     "SEARCH TABLE employees USING INTEGER PRIMARY KEY (rowid=?)",
+				},
+			}},
+		},
+		{
+			gsorm.MySQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees").Where("first_name = ?", "Georgi")
+			},
+			nil,
+			mysqlColumns,
+			[][]interface{}{{
+				int64(1), []byte("SIMPLE"), []byte("employees"), nil, []byte("ref"), []byte("idx_first_name"),
+				[]byte("idx_first_name"), []byte("58"), []byte("const"), int64(253), 100.0, nil,
+			}},
+			"EXPLAIN SELECT * FROM employees WHERE first_name = 'Georgi'",
+			&explain.Plan{Nodes: []explain.Node{
+				{Table: "employees", AccessType: "ref", Key: "idx_first_name", Rows: 253},
+			}},
+		},
+		{
+			gsorm.MySQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees AS e").Join("salaries AS s").On("e.emp_no = s.emp_no").
+					Where("s.salary > ?", 60000)
+			},
+			[]explain.Option{explain.JSON()},
+			[]string{"EXPLAIN"},
+			[][]interface{}{{[]byte(mysqlJSON)}},
+			"EXPLAIN FORMAT=JSON SELECT * FROM employees AS e INNER JOIN salaries AS s ON e.emp_no = s.emp_no " +
+				"WHERE s.salary > 60000",
+			&explain.Plan{
+				Nodes: []explain.Node{
+					{Table: "e", AccessType: "ALL", Rows: 300024, Cost: 30.25},
+					{Table: "s", AccessType: "ref", Key: "PRIMARY", Rows: 9, Cost: 65.5, Detail: "(s.salary > 60000)"},
+				},
+				Raw: mysqlJSON,
+			},
+		},
+		{
+			gsorm.MySQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees").Where("first_name = ?", "Georgi")
+			},
+			[]explain.Option{explain.Analyze()},
+			[]string{"EXPLAIN"},
+			[][]interface{}{{[]byte(mysqlTree)}},
+			"EXPLAIN ANALYZE SELECT * FROM employees WHERE first_name = 'Georgi'",
+			&explain.Plan{
+				Nodes: []explain.Node{
+					{
+						AccessType: "Filter",
+						Rows:       1,
+						Cost:       0.35,
+						Detail: "Filter: (employees.first_name = 'Georgi')  (cost=0.35 rows=1) " +
+							"(actual time=0.02..0.03 rows=1 loops=1)",
+					},
+					{
+						Table:      "employees",
+						AccessType: "Index lookup",
+						Key:        "idx_first_name",
+						Rows:       253,
+						Cost:       0.35,
+						Detail: "Index lookup on employees using idx_first_name (first_name='Georgi')  " +
+							"(cost=0.35 rows=253) (actual time=0.02..0.03 rows=253 loops=1)",
+					},
+				},
+				Raw: mysqlTree,
+			},
+		},
+		{
+			gsorm.PostgreSQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Update(db, "employees").Set("first_name", "Hanako").Where("emp_no = ?", 1001)
+			},
+			[]explain.Option{explain.Analyze()},
+			[]string{"QUERY PLAN"},
+			[][]interface{}{{[]byte(pgJSON)}},
+			"EXPLAIN (ANALYZE, FORMAT JSON) UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001",
+			&explain.Plan{
+				Nodes: []explain.Node{
+					{Table: "employees", AccessType: "ModifyTable", Cost: 8.31},
+					{
+						Table:      "employees",
+						AccessType: "Index Scan",
+						Key:        "employees_pkey",
+						Rows:       1,
+						Cost:       8.31,
+						Detail:     "(emp_no = 1001)",
+					},
+				},
+				Raw: pgJSON,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		fdb, db := newExplainDB(testCase.Dialect, testCase.Columns, testCase.Values)
+		plan, err := testCase.Stmt(db).Explain(testCase.Options...)
+		if err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			continue
+		}
+		assert.DeepEqual(t, []string{testCase.ExpectedSQL}, fdb.queries)
+		assert.DeepEqual(t, testCase.ExpectedPlan, plan)
+	}
+}
+
+func TestExplain_UsesIndex(t *testing.T) {
+	// The hot query which selects employees by last name must use the index.
+	_, db := newExplainDB(gsorm.SQLite, []string{"id", "parent", "notused", "detail"},
+		[][]interface{}{{int64(2), int64(0), int64(0), []byte("SEARCH employees USING INDEX idx_last_name (last_name=?)")}})
+	plan, err := gsorm.Select(db, "emp_no").From("employees").Where("last_name = ?", "Suzuki").Explain()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, true, plan.UsesIndex("idx_last_name"))
+	assert.Equal(t, false, plan.FullScan())
+
+	_, db = newExplainDB(gsorm.SQLite, []string{"id", "parent", "notused", "detail"},
+		[][]interface{}{{int64(2), int64(0), int64(0), []byte("SCAN employees")}})
+	plan, err = gsorm.Select(db, "emp_no").From("employees").Where("first_name = ?", "Taro").Explain()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, false, plan.UsesIndex(""))
+	assert.Equal(t, true, plan.FullScan())
+}
+
+func TestExplain_Mock(t *testing.T) {
+	expected := explain.Plan{Nodes: []explain.Node{{Table: "employees", AccessType: "range", Key: "PRIMARY", Rows: 10}}}
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Delete(nil).From("employees").Where("emp_no < ?", 1010), expected)
+	plan, err := gsorm.Delete(mock).From("employees").Where("emp_no < ?", 1010).Explain()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, &expected, plan)
+}
+
+func TestExplain_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo int
+	}
+
+	testCases := []struct {
+		Dialect       gsorm.Dialect
+		Stmt          func(db gsorm.DB) interfaces.Explainer
+		Options       []explain.Option
+		ExpectedError string
+	}{
+		{
+			gsorm.SQLite,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees")
+			},
+			[]explain.Option{explain.Analyze()},
+			"EXPLAIN ANALYZE is not supported by sqlite3",
+		},
+		{
+			gsorm.SQLite,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees")
+			},
+			[]explain.Option{explain.JSON()},
+			"FORMAT=JSON is not supported by sqlite3",
+		},
+		{
+			gsorm.MySQL5,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees")
+			},
+			[]explain.Option{explain.Analyze()},
+			"EXPLAIN ANALYZE is not supported by mysql5",
+		},
+		{
+			gsorm.MySQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Select(db).From("employees")
+			},
+			[]explain.Option{explain.Analyze(), explain.JSON()},
+			"EXPLAIN ANALYZE with FORMAT=JSON is not supported by mysql",
+		},
+		{
+			gsorm.MySQL,
+			func(db gsorm.DB) interfaces.Explainer {
+				return gsorm.Insert(db, "employees").Model(&[]Employee{{EmpNo: 1001}}).Batch(100)
+			},
+			nil,
+			"EXPLAIN cannot be used with batch insert",
+		},
+	}
+
+	for _, testCase := range testCases {
+		fdb, db := newExplainDB(testCase.Dialect, nil, nil)
+		_, err := testCase.Stmt(db).Explain(testCase.Options...)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+		assert.Equal(t, 0, len(fdb.queries))
+	}
+}
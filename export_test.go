@@ -30,3 +30,27 @@ func (s *stmt) ExportedGetErrors() []error {
 func (m *migStmt) ExportedGetErrors() []error {
 	return m.errors
 }
+
+// ExportedDialectDB is DB which knows its SQL dialect.
+type ExportedDialectDB struct {
+	DB
+	Dialect Dialect
+}
+
+func (d *ExportedDialectDB) dialect() Dialect {
+	return d.Dialect
+}
+
+// ExportedClockDB is DB which has its clock.
+type ExportedClockDB struct {
+	DB
+	Clock Clock
+}
+
+func (d *ExportedClockDB) clock() Clock {
+	return d.Clock
+}
+
+func (d *ExportedClockDB) dialect() Dialect {
+	return dialectOf(d.DB)
+}
@@ -0,0 +1,241 @@
+// Package expr provides the builders of SQL expressions like function call, CASE expression and arithmetic operation.
+//
+// The expressions can be used as the columns of gsorm.Select, (*SelectStmt).OrderBy and (*SelectStmt).GroupBy,
+// the value of (*UpdateStmt).Set, and the values of (*SelectStmt).Where, (*SelectStmt).Having and so on.
+//
+// The arguments of the builders are converted by the following rules:
+//   - string is used as SQL as is, like the column name
+//   - expression which is built by this package is nested
+//   - gsorm statement is nested as subquery
+//   - nil is converted to NULL
+//   - the other values are assigned to the placeholder, so the string value should be wrapped by Value
+//
+// Like the expression of (*SelectStmt).Where, '%' in the string argument must be escaped as '%%'.
+package expr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// Expression is SQL expression.
+// SQL has '?' placeholders which Values are assigned to.
+type Expression struct {
+	SQL    string
+	Values []interface{}
+}
+
+// Expr returns the expression with placeholders and the values.
+func (e *Expression) Expr() (string, []interface{}) {
+	return e.SQL, e.Values
+}
+
+// String returns the expression whose values are assigned.
+func (e *Expression) String() string {
+	s, err := syntax.BuildExpr(e.SQL, e.Values...)
+	if err != nil {
+		return e.SQL
+	}
+	return s
+}
+
+// As returns the expression with alias.
+func (e *Expression) As(alias string) *Expression {
+	return &Expression{SQL: fmt.Sprintf("%s AS %s", e.SQL, alias), Values: e.Values}
+}
+
+// Raw returns the expression with placeholders and the values.
+func Raw(sql string, values ...interface{}) *Expression {
+	return &Expression{SQL: sql, Values: values}
+}
+
+// Value returns the expression of the value.
+// Single quotes in the string are escaped.
+func Value(v interface{}) *Expression {
+	if v == nil {
+		return &Expression{SQL: "NULL"}
+	}
+	if s, ok := v.(string); ok {
+		v = strings.ReplaceAll(s, "'", "''")
+	}
+	return &Expression{SQL: "?", Values: []interface{}{v}}
+}
+
+// Fn returns the expression of function call like "NAME(arg1, arg2)".
+func Fn(name string, args ...interface{}) *Expression {
+	s, values := join(", ", args)
+	return &Expression{SQL: fmt.Sprintf("%s(%s)", name, s), Values: values}
+}
+
+// Count returns the expression of COUNT function.
+// If args is empty, "COUNT(*)" is returned.
+func Count(args ...interface{}) *Expression {
+	if len(args) == 0 {
+		return Fn("COUNT", "*")
+	}
+	return Fn("COUNT", args...)
+}
+
+// Sum returns the expression of SUM function.
+func Sum(arg interface{}) *Expression {
+	return Fn("SUM", arg)
+}
+
+// Avg returns the expression of AVG function.
+func Avg(arg interface{}) *Expression {
+	return Fn("AVG", arg)
+}
+
+// Max returns the expression of MAX function.
+func Max(arg interface{}) *Expression {
+	return Fn("MAX", arg)
+}
+
+// Min returns the expression of MIN function.
+func Min(arg interface{}) *Expression {
+	return Fn("MIN", arg)
+}
+
+// Distinct returns the expression like "DISTINCT arg1, arg2".
+func Distinct(args ...interface{}) *Expression {
+	s, values := join(", ", args)
+	return &Expression{SQL: fmt.Sprintf("DISTINCT %s", s), Values: values}
+}
+
+// Coalesce returns the expression of COALESCE function.
+func Coalesce(args ...interface{}) *Expression {
+	return Fn("COALESCE", args...)
+}
+
+// Cast returns the expression like "CAST(arg AS typ)".
+func Cast(arg interface{}, typ string) *Expression {
+	s, values := build(arg)
+	return &Expression{SQL: fmt.Sprintf("CAST(%s AS %s)", s, typ), Values: values}
+}
+
+// Add returns the expression like "(lhs + rhs)".
+func Add(lhs, rhs interface{}) *Expression {
+	return operate("+", lhs, rhs)
+}
+
+// Sub returns the expression like "(lhs - rhs)".
+func Sub(lhs, rhs interface{}) *Expression {
+	return operate("-", lhs, rhs)
+}
+
+// Mul returns the expression like "(lhs * rhs)".
+func Mul(lhs, rhs interface{}) *Expression {
+	return operate("*", lhs, rhs)
+}
+
+// Div returns the expression like "(lhs / rhs)".
+func Div(lhs, rhs interface{}) *Expression {
+	return operate("/", lhs, rhs)
+}
+
+// Mod returns the expression like "(lhs % rhs)".
+func Mod(lhs, rhs interface{}) *Expression {
+	// '%' is escaped since the expression is used as the format.
+	return operate("%%", lhs, rhs)
+}
+
+// operate returns the expression of the arithmetic operation.
+// It is enclosed by parentheses so that it can be nested.
+func operate(op string, lhs, rhs interface{}) *Expression {
+	s, values := join(fmt.Sprintf(" %s ", op), []interface{}{lhs, rhs})
+	return &Expression{SQL: fmt.Sprintf("(%s)", s), Values: values}
+}
+
+// When is the pair of the condition and the result of CASE expression.
+type When struct {
+	Cond interface{}
+	Then interface{}
+}
+
+// CaseExpression is CASE expression.
+type CaseExpression struct {
+	Whens     []When
+	ElseValue interface{}
+	HasElse   bool
+}
+
+// Case returns CASE expression.
+func Case() *CaseExpression {
+	return &CaseExpression{}
+}
+
+// When returns CASE expression to which "WHEN cond THEN then" is added.
+func (c *CaseExpression) When(cond, then interface{}) *CaseExpression {
+	r := *c
+	r.Whens = append(c.Whens[:len(c.Whens):len(c.Whens)], When{Cond: cond, Then: then})
+	return &r
+}
+
+// Else returns CASE expression to which "ELSE v" is added.
+func (c *CaseExpression) Else(v interface{}) *CaseExpression {
+	r := *c
+	r.ElseValue = v
+	r.HasElse = true
+	return &r
+}
+
+// Expr returns the expression with placeholders and the values.
+func (c *CaseExpression) Expr() (string, []interface{}) {
+	s := "CASE"
+	values := []interface{}{}
+	for _, w := range c.Whens {
+		cond, cv := build(w.Cond)
+		then, tv := build(w.Then)
+		s += fmt.Sprintf(" WHEN %s THEN %s", cond, then)
+		values = append(values, cv...)
+		values = append(values, tv...)
+	}
+	if c.HasElse {
+		e, ev := build(c.ElseValue)
+		s += fmt.Sprintf(" ELSE %s", e)
+		values = append(values, ev...)
+	}
+	return s + " END", values
+}
+
+// String returns the expression whose values are assigned.
+func (c *CaseExpression) String() string {
+	s, values := c.Expr()
+	return (&Expression{SQL: s, Values: values}).String()
+}
+
+// As returns the expression with alias.
+func (c *CaseExpression) As(alias string) *Expression {
+	s, values := c.Expr()
+	return (&Expression{SQL: s, Values: values}).As(alias)
+}
+
+// join builds the arguments and joins them with sep.
+func join(sep string, args []interface{}) (string, []interface{}) {
+	strs := make([]string, len(args))
+	values := []interface{}{}
+	for i, a := range args {
+		s, v := build(a)
+		strs[i] = s
+		values = append(values, v...)
+	}
+	return strings.Join(strs, sep), values
+}
+
+// build converts the argument to the expression and the values.
+func build(arg interface{}) (string, []interface{}) {
+	switch arg := arg.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return arg, nil
+	case interfaces.Expr:
+		return arg.Expr()
+	case interfaces.Stmt:
+		return "(?)", []interface{}{arg}
+	}
+	return "?", []interface{}{arg}
+}
@@ -0,0 +1,97 @@
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/expr"
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpr(t *testing.T) {
+	testCases := []struct {
+		Expr     interfaces.Expr
+		Expected string
+	}{
+		{
+			expr.Fn("CONCAT", "first_name", expr.Value(" "), "last_name"),
+			`CONCAT(first_name, ' ', last_name)`,
+		},
+		{
+			expr.Fn("NOW"),
+			`NOW()`,
+		},
+		{
+			expr.Count(),
+			`COUNT(*)`,
+		},
+		{
+			expr.Count(expr.Distinct("emp_no")).As("num"),
+			`COUNT(DISTINCT emp_no) AS num`,
+		},
+		{
+			expr.Sum("salary"),
+			`SUM(salary)`,
+		},
+		{
+			expr.Coalesce("nickname", "first_name", expr.Value("O'Brien")),
+			`COALESCE(nickname, first_name, 'O''Brien')`,
+		},
+		{
+			expr.Coalesce("bonus", nil, 0),
+			`COALESCE(bonus, NULL, 0)`,
+		},
+		{
+			expr.Cast("emp_no", "CHAR"),
+			`CAST(emp_no AS CHAR)`,
+		},
+		{
+			expr.Add(expr.Mul("salary", 1.1), 1000),
+			`((salary * 1.1) + 1000)`,
+		},
+		{
+			expr.Div(expr.Sub("to_date", "from_date"), 365).As("years"),
+			`((to_date - from_date) / 365) AS years`,
+		},
+		{
+			expr.Mod("emp_no", 2),
+			`(emp_no % 2)`,
+		},
+		{
+			expr.Case().
+				When(expr.Raw("salary > ?", 100000), expr.Value("high")).
+				When("salary > 50000", expr.Value("middle")).
+				Else(expr.Value("low")).As("level"),
+			`CASE WHEN salary > 100000 THEN 'high' WHEN salary > 50000 THEN 'middle' ELSE 'low' END AS level`,
+		},
+		{
+			expr.Case().When("gender = 'M'", 1),
+			`CASE WHEN gender = 'M' THEN 1 END`,
+		},
+		{
+			expr.Fn("EXISTS", gsorm.Select(nil).From("dept_manager").Where("emp_no = ?", 1001)),
+			`EXISTS((SELECT * FROM dept_manager WHERE emp_no = 1001))`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := syntax.BuildExpr("?", testCase.Expr)
+		if err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestCaseExpression_Immutable(t *testing.T) {
+	base := expr.Case().When("gender = 'M'", 1)
+	c1 := base.When("gender = 'F'", 2)
+	c2 := base.Else(0)
+
+	assert.Equal(t, `CASE WHEN gender = 'M' THEN 1 END`, base.String())
+	assert.Equal(t, `CASE WHEN gender = 'M' THEN 1 WHEN gender = 'F' THEN 2 END`, c1.String())
+	assert.Equal(t, `CASE WHEN gender = 'M' THEN 1 ELSE 0 END`, c2.String())
+}
@@ -1,6 +1,7 @@
 package gsorm_test
 
 import (
+	"database/sql"
 	"reflect"
 	"time"
 
@@ -8,23 +9,52 @@ import (
 )
 
 type fakeDB struct {
-	r gsorm.ExportedIRows
+	r   gsorm.ExportedIRows
+	res gsorm.ExportedIResult
+
+	// Rows which are returned by the queries in order.
+	// If it's empty, r is returned.
+	rs []gsorm.ExportedIRows
+
+	// Executed queries.
+	execs []string
+
+	// Queried statements.
+	queries []string
+
+	// Begun transaction.
+	tx *fakeTx
 }
 
 func newFakeDB(r gsorm.ExportedIRows) gsorm.DB {
 	return &fakeDB{r: r}
 }
 
+func newFakeDBWithRows(rs ...gsorm.ExportedIRows) gsorm.DB {
+	return &fakeDB{rs: rs}
+}
+
+func newFakeDBWithResult(res gsorm.ExportedIResult) gsorm.DB {
+	return &fakeDB{res: res}
+}
+
 func (d *fakeDB) Ping() error {
 	return nil
 }
 
 func (d *fakeDB) Query(query string, args ...interface{}) (gsorm.ExportedIRows, error) {
+	d.queries = append(d.queries, query)
+	if len(d.rs) > 0 {
+		r := d.rs[0]
+		d.rs = d.rs[1:]
+		return r, nil
+	}
 	return d.r, nil
 }
 
 func (d *fakeDB) Exec(query string, args ...interface{}) (gsorm.ExportedIResult, error) {
-	return nil, nil
+	d.execs = append(d.execs, query)
+	return d.res, nil
 }
 
 func (d *fakeDB) SetConnMaxLifetime(n time.Duration) error {
@@ -44,7 +74,36 @@ func (d *fakeDB) Close() error {
 }
 
 func (d *fakeDB) Begin() (gsorm.Tx, error) {
-	return nil, nil
+	d.tx = &fakeTx{db: d}
+	return d.tx, nil
+}
+
+type fakeTx struct {
+	db         *fakeDB
+	committed  bool
+	rolledBack bool
+}
+
+func (t *fakeTx) Ping() error {
+	return nil
+}
+
+func (t *fakeTx) Query(query string, args ...interface{}) (gsorm.ExportedIRows, error) {
+	return t.db.Query(query, args...)
+}
+
+func (t *fakeTx) Exec(query string, args ...interface{}) (gsorm.ExportedIResult, error) {
+	return t.db.Exec(query, args...)
+}
+
+func (t *fakeTx) Commit() error {
+	t.committed = true
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.rolledBack = true
+	return nil
 }
 
 type fakeRows struct {
@@ -67,8 +126,25 @@ func (r *fakeRows) Next() bool {
 
 func (r *fakeRows) Scan(args ...interface{}) error {
 	for i, a := range args {
+		if s, ok := a.(sql.Scanner); ok {
+			if err := s.Scan(r.v[r.itr][i]); err != nil {
+				return err
+			}
+			continue
+		}
+		dest := reflect.ValueOf(a).Elem()
 		v := reflect.ValueOf(r.v[r.itr][i])
-		reflect.ValueOf(a).Elem().Set(v)
+		// NULL is scanned as zero value.
+		if !v.IsValid() {
+			v = reflect.Zero(dest.Type())
+		}
+		// The pointer is allocated like database/sql.
+		if dest.Kind() == reflect.Ptr && v.Type() != dest.Type() {
+			ptr := reflect.New(dest.Type().Elem())
+			ptr.Elem().Set(v)
+			v = ptr
+		}
+		dest.Set(v)
 	}
 	return nil
 }
@@ -81,6 +157,23 @@ func (r *fakeRows) Close() error {
 	return nil
 }
 
+type fakeResult struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func newFakeResult(lastInsertID, rowsAffected int64) gsorm.ExportedIResult {
+	return &fakeResult{lastInsertID: lastInsertID, rowsAffected: rowsAffected}
+}
+
+func (r *fakeResult) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+func (r *fakeResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
 type fakeColumnType struct {
 	n string
 	t reflect.Type
@@ -0,0 +1,317 @@
+// Package fingerprint provides the normalization of SQL statement which is used to group the queries by their shape.
+//
+// The normalized SQL doesn't contain the values, so it can be logged or used as the label of metrics safely.
+// The normalization is done by the following rules:
+//   - string and number literals and the placeholders like "$1" are replaced by '?'
+//   - the list of IN clause like "IN (?, ?, ?)" is collapsed into "IN (?)"
+//   - the repeated rows of VALUES clause are collapsed into one row
+//   - comments are removed and whitespaces are canonicalized
+package fingerprint
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// Fingerprint is the normalized SQL and its hash.
+type Fingerprint struct {
+	// SQL is the normalized SQL.
+	SQL string
+
+	// Hash is the hex encoded 64-bit FNV-1a hash of the normalized SQL.
+	// It's stable across the processes and the versions of Go.
+	Hash string
+}
+
+// String returns the hash.
+func (f Fingerprint) String() string {
+	return f.Hash
+}
+
+// New returns the fingerprint of the SQL.
+func New(sql string) Fingerprint {
+	n := Normalize(sql)
+	h := fnv.New64a()
+	h.Write([]byte(n))
+	return Fingerprint{SQL: n, Hash: fmt.Sprintf("%016x", h.Sum64())}
+}
+
+// Normalize returns the normalized SQL.
+func Normalize(sql string) string {
+	tokens := collapseValues(collapseIn(tokenize(sql)))
+
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && needsSpace(tokens[i-1], t) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// kind is the kind of token.
+type kind int
+
+const (
+	word kind = iota
+	literal
+	punct
+	operator
+)
+
+// token is the token of SQL.
+type token struct {
+	kind kind
+	text string
+
+	// spaced reports whether the token is preceded by whitespaces in the original SQL.
+	spaced bool
+}
+
+// is reports whether the token is the punctuation or the keyword s.
+func (t token) is(s string) bool {
+	return (t.kind == punct || t.kind == word) && strings.EqualFold(t.text, s)
+}
+
+// needsSpace reports whether the space is needed between the tokens.
+func needsSpace(prev, t token) bool {
+	switch {
+	case prev.is("(") || prev.is("."):
+		return false
+	case t.is(")") || t.is(",") || t.is(";") || t.is("."):
+		return false
+	case t.is("("):
+		// The space before '(' is kept to distinguish "COUNT(*)" from "IN (?)".
+		return t.spaced || prev.kind != word || prev.is("IN") || prev.is("VALUES")
+	}
+	return true
+}
+
+// tokenize splits the SQL into tokens. The literals are replaced by '?'.
+func tokenize(sql string) []token {
+	var tokens []token
+	spaced := false
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case isSpace(c):
+			spaced = true
+			i++
+			continue
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			i = skipUntil(sql, i, "\n")
+			spaced = true
+			continue
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			i = skipUntil(sql, i+2, "*/")
+			spaced = true
+			continue
+		}
+
+		var t token
+		switch {
+		case c == '\'':
+			i = skipQuoted(sql, i, '\'', true)
+			t = token{kind: literal, text: "?"}
+		case c == '"' || c == '`':
+			// Double quotes and backquotes enclose the identifier.
+			j := skipQuoted(sql, i, c, false)
+			t = token{kind: word, text: sql[i:j]}
+			i = j
+		case c == '?':
+			i++
+			t = token{kind: literal, text: "?"}
+		case c == '$' && i+1 < len(sql) && isDigit(sql[i+1]):
+			i = skipNumber(sql, i+1)
+			t = token{kind: literal, text: "?"}
+		case isDigit(c) || (c == '.' && i+1 < len(sql) && isDigit(sql[i+1])):
+			i = skipNumber(sql, i)
+			t = token{kind: literal, text: "?"}
+		case c == '-' && i+1 < len(sql) && isDigit(sql[i+1]) && isUnary(tokens):
+			i = skipNumber(sql, i+1)
+			t = token{kind: literal, text: "?"}
+		case isWordStart(c):
+			j := i
+			for j < len(sql) && isWordPart(sql[j]) {
+				j++
+			}
+			t = token{kind: word, text: sql[i:j]}
+			i = j
+		case strings.IndexByte("(),;.", c) >= 0:
+			i++
+			t = token{kind: punct, text: string(c)}
+		default:
+			j := i
+			for j < len(sql) && strings.IndexByte("<>=!|&+-*/%:~^@#", sql[j]) >= 0 {
+				// The minus of the negative number like "=-1" is not the part of operator.
+				if j > i && sql[j] == '-' && j+1 < len(sql) && isDigit(sql[j+1]) {
+					break
+				}
+				j++
+			}
+			if j == i {
+				j++
+			}
+			t = token{kind: operator, text: sql[i:j]}
+			i = j
+		}
+
+		t.spaced = spaced
+		spaced = false
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+// collapseIn collapses the list of literals of IN clause like "IN (?, ?, ?)" into "IN (?)".
+func collapseIn(tokens []token) []token {
+	var out []token
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if !tokens[i].is("IN") || i+2 >= len(tokens) || !tokens[i+1].is("(") || tokens[i+2].kind != literal {
+			continue
+		}
+
+		// Find the end of the list which consists of only literals and commas.
+		j := i + 3
+		for j+1 < len(tokens) && tokens[j].is(",") && tokens[j+1].kind == literal {
+			j += 2
+		}
+		if j < len(tokens) && tokens[j].is(")") {
+			out = append(out, tokens[i+1], tokens[i+2], tokens[j])
+			i = j
+		}
+	}
+	return out
+}
+
+// collapseValues collapses the repeated rows of VALUES clause like "VALUES (?, ?), (?, ?)" into "VALUES (?, ?)".
+func collapseValues(tokens []token) []token {
+	var out []token
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if !tokens[i].is("VALUES") {
+			continue
+		}
+
+		row, end := rowAt(tokens, i+1)
+		if row == nil {
+			continue
+		}
+		out = append(out, row...)
+		i = end - 1
+		for i+1 < len(tokens) && tokens[i+1].is(",") {
+			next, nextEnd := rowAt(tokens, i+2)
+			if !sameTokens(row, next) {
+				break
+			}
+			i = nextEnd - 1
+		}
+	}
+	return out
+}
+
+// rowAt returns the tokens of the parenthesized row which starts at i and the index next to it.
+// If the row doesn't start at i, it returns nil.
+func rowAt(tokens []token, i int) ([]token, int) {
+	if i >= len(tokens) || !tokens[i].is("(") {
+		return nil, i
+	}
+	depth := 0
+	for j := i; j < len(tokens); j++ {
+		switch {
+		case tokens[j].is("("):
+			depth++
+		case tokens[j].is(")"):
+			depth--
+			if depth == 0 {
+				return tokens[i : j+1], j + 1
+			}
+		}
+	}
+	return nil, i
+}
+
+// sameTokens reports whether the texts of tokens are same.
+func sameTokens(a, b []token) bool {
+	if len(a) != len(b) || a == nil {
+		return false
+	}
+	for i := range a {
+		if a[i].text != b[i].text {
+			return false
+		}
+	}
+	return true
+}
+
+// isUnary reports whether '-' after the tokens is the unary minus.
+func isUnary(tokens []token) bool {
+	if len(tokens) == 0 {
+		return true
+	}
+	prev := tokens[len(tokens)-1]
+	return prev.kind == operator || (prev.kind == punct && prev.text != ")")
+}
+
+// skipQuoted returns the index next to the quoted string which starts at i.
+// The doubled quote is treated as the escaped quote. If backslash is true, the backslash escape is also treated.
+func skipQuoted(sql string, i int, quote byte, backslash bool) int {
+	for j := i + 1; j < len(sql); j++ {
+		switch {
+		case backslash && sql[j] == '\\':
+			j++
+		case sql[j] == quote:
+			if j+1 < len(sql) && sql[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(sql)
+}
+
+// skipNumber returns the index next to the number which starts at i.
+func skipNumber(sql string, i int) int {
+	j := i
+	for j < len(sql) {
+		c := sql[j]
+		switch {
+		case isDigit(c) || c == '.' || c == 'x' || c == 'X' || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F'):
+			j++
+		case (c == '+' || c == '-') && (sql[j-1] == 'e' || sql[j-1] == 'E'):
+			j++
+		default:
+			return j
+		}
+	}
+	return j
+}
+
+// skipUntil returns the index next to end which appears after i.
+func skipUntil(sql string, i int, end string) int {
+	j := strings.Index(sql[i:], end)
+	if j < 0 {
+		return len(sql)
+	}
+	return i + j + len(end)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isWordPart(c byte) bool {
+	return isWordStart(c) || isDigit(c) || c == '$'
+}
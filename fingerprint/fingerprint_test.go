@@ -0,0 +1,91 @@
+package fingerprint_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/fingerprint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		SQL      string
+		Expected string
+	}{
+		{
+			"SELECT * FROM employees WHERE emp_no = 1001",
+			"SELECT * FROM employees WHERE emp_no = ?",
+		},
+		{
+			"SELECT * FROM employees WHERE first_name = 'Taro' AND last_name = 'O''Brien'",
+			"SELECT * FROM employees WHERE first_name = ? AND last_name = ?",
+		},
+		{
+			`SELECT * FROM employees WHERE first_name = 'Ta\'ro'`,
+			"SELECT * FROM employees WHERE first_name = ?",
+		},
+		{
+			"SELECT * FROM salaries WHERE salary > 60000.5 AND diff=-1.5e3",
+			"SELECT * FROM salaries WHERE salary > ? AND diff = ?",
+		},
+		{
+			"SELECT * FROM employees WHERE emp_no IN (1001, 1002, 1003)",
+			"SELECT * FROM employees WHERE emp_no IN (?)",
+		},
+		{
+			"SELECT * FROM employees WHERE first_name IN ('Taro', 'Jiro')",
+			"SELECT * FROM employees WHERE first_name IN (?)",
+		},
+		{
+			"SELECT * FROM employees WHERE emp_no IN (SELECT emp_no FROM dept_manager)",
+			"SELECT * FROM employees WHERE emp_no IN (SELECT emp_no FROM dept_manager)",
+		},
+		{
+			"SELECT  e.emp_no,COUNT(*)\n\tFROM employees AS e\n\tGROUP BY e.emp_no",
+			"SELECT e.emp_no, COUNT(*) FROM employees AS e GROUP BY e.emp_no",
+		},
+		{
+			"SELECT * FROM employees -- comment\nWHERE /* comment */ emp_no = 1001",
+			"SELECT * FROM employees WHERE emp_no = ?",
+		},
+		{
+			"SELECT * FROM employees WHERE emp_no = $1 AND first_name = ?",
+			"SELECT * FROM employees WHERE emp_no = ? AND first_name = ?",
+		},
+		{
+			`SELECT "first_name", ` + "`last_name` FROM employees",
+			`SELECT "first_name", ` + "`last_name` FROM employees",
+		},
+		{
+			"INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro'), (1003, 'Saburo')",
+			"INSERT INTO employees (emp_no, first_name) VALUES (?, ?)",
+		},
+		{
+			"INSERT INTO employees (emp_no, hire_date) VALUES (1001, NOW()), (1002, NOW())",
+			"INSERT INTO employees (emp_no, hire_date) VALUES (?, NOW())",
+		},
+		{
+			"UPDATE salaries SET salary = (salary * 1.1) WHERE from_date < '2006-01-02 15:04:05'",
+			"UPDATE salaries SET salary = (salary * ?) WHERE from_date < ?",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.Expected, fingerprint.Normalize(testCase.SQL))
+	}
+}
+
+func TestNew(t *testing.T) {
+	f1 := fingerprint.New("SELECT * FROM employees WHERE emp_no IN (1001, 1002)")
+	f2 := fingerprint.New("SELECT *  FROM employees\nWHERE emp_no IN (1003)")
+	f3 := fingerprint.New("SELECT * FROM employees WHERE first_name IN ('Taro')")
+
+	assert.Equal(t, "SELECT * FROM employees WHERE emp_no IN (?)", f1.SQL)
+	assert.Equal(t, f1, f2)
+	assert.NotEqual(t, f1.Hash, f3.Hash)
+	assert.Equal(t, 16, len(f1.Hash))
+	assert.Equal(t, f1.Hash, f1.String())
+
+	// The hash is stable.
+	assert.Equal(t, "199e7dca63ea8858", fingerprint.New("SELECT ?").Hash)
+}
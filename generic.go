@@ -0,0 +1,210 @@
+package gsorm
+
+import (
+	"reflect"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/interfaces/iinsert"
+	"github.com/champon1020/gsorm/interfaces/iupdate"
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
+)
+
+// firstQuerier is implemented by the statement which can call First.
+type firstQuerier interface {
+	First(model interface{}) error
+}
+
+// scalarScanner is implemented by the statement which can scan the first column of the first row.
+type scalarScanner interface {
+	scanScalar(dest interface{}) error
+}
+
+// Find executes the statement and returns the rows as the slice of T.
+//
+//	employees, err := gsorm.Find[Employee](gsorm.Select(db).From("employees"))
+func Find[T any](stmt interfaces.QueryCallable) ([]T, error) {
+	var model []T
+	if err := stmt.Query(&model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// One executes the statement and returns the first row as T.
+// If no row is selected, it returns ErrNoRows.
+// If the statement is SELECT statement, it's executed by (*SelectStmt).First.
+func One[T any](stmt interfaces.QueryCallable) (T, error) {
+	var model T
+	if s, ok := stmt.(firstQuerier); ok {
+		err := s.First(&model)
+		return model, err
+	}
+
+	models, err := Find[T](stmt)
+	if err != nil {
+		return model, err
+	}
+	if len(models) == 0 {
+		return model, ErrNoRows
+	}
+	return models[0], nil
+}
+
+// Scalar executes the statement and returns the value of the first column of the first row as T.
+// Unlike (*SelectStmt).Int64, the value is scanned into T directly by the driver.
+// If no row is selected, it returns ErrNoRows.
+//
+//	n, err := gsorm.Scalar[int64](gsorm.Count(db).From("employees"))
+func Scalar[T any](stmt interfaces.QueryCallable) (T, error) {
+	var v T
+	s, ok := stmt.(scalarScanner)
+	if !ok {
+		return v, xerrors.Errorf("%s cannot be used for Scalar", reflect.TypeOf(stmt).String())
+	}
+	err := s.scanScalar(&v)
+	return v, err
+}
+
+// InsertOf calls INSERT command with the models of T.
+// The columns are the mapped columns of all fields of T, so T must be struct.
+//
+//	err := gsorm.InsertOf(db, "employees", employees...).Exec()
+func InsertOf[T any](conn conn, table string, models ...T) iinsert.Model {
+	cols, err := writableColumns(reflect.TypeOf((*T)(nil)).Elem())
+	s := newInsertStmt(conn, table, cols...)
+	if err != nil {
+		s.throw(err)
+	}
+	if len(models) == 0 {
+		s.throw(xerrors.New("models must not be empty"))
+	}
+	return s.Model(&models)
+}
+
+// UpdateOf calls UPDATE command with the model of T.
+// If the columns are empty, they are the mapped columns of all fields of T, so T must be struct.
+// The model is taken by pointer, so that the version and the timestamps of the model are updated.
+//
+//	err := gsorm.UpdateOf(db, "employees", &employee, "first_name").Where("emp_no = ?", 1001).Exec()
+func UpdateOf[T any](conn conn, table string, model *T, columns ...string) iupdate.Model {
+	s := newUpdateStmt(conn, table)
+	if len(columns) == 0 {
+		cols, err := updatableColumns(reflect.TypeOf(model).Elem())
+		if err != nil {
+			s.throw(err)
+		}
+		columns = cols
+	}
+	return s.Model(model, columns...)
+}
+
+// modelColumns returns the mapped columns of the exported fields of the struct.
+// The fields of associations are not mapped.
+// The fields of embedded struct and nested struct tagged with prefix are flattened,
+// and the fields of nested struct without prefix are not mapped since they belong to another table.
+func modelColumns(typ reflect.Type) ([]string, error) {
+	return filterColumns(typ, func(tag *internal.Tag) bool {
+		return true
+	})
+}
+
+// writableColumns returns the mapped columns of the struct except the soft delete column,
+// which is written only by the soft delete.
+func writableColumns(typ reflect.Type) ([]string, error) {
+	return filterColumns(typ, func(tag *internal.Tag) bool {
+		return !tag.SoftDelete
+	})
+}
+
+// updatableColumns returns the writable columns of the struct except the columns tagged with autocreatetime,
+// which must not be changed after the row is inserted.
+func updatableColumns(typ reflect.Type) ([]string, error) {
+	return filterColumns(typ, func(tag *internal.Tag) bool {
+		return !tag.SoftDelete && !tag.AutoCreateTime
+	})
+}
+
+// filterColumns returns the mapped columns of the exported fields of the struct whose tags satisfy the condition.
+func filterColumns(typ reflect.Type, cond func(*internal.Tag) bool) ([]string, error) {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, xerrors.Errorf("%v is invalid type for model columns", typ)
+	}
+
+	var cols []string
+	for _, f := range tableFields(typ) {
+		if cond(f.tag) {
+			cols = append(cols, f.column)
+		}
+	}
+	return cols, nil
+}
+
+// scalar executes SQL statement and scans the first column of the first row into dest.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it maps expected value to dest.
+func (s *stmt) scalar(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt, dest interface{}) error {
+	if len(s.errors) > 0 {
+		return s.errors[0]
+	}
+
+	switch conn := s.conn.(type) {
+	case Mock:
+		returned, err := conn.compareWith(stmt)
+		if err != nil || returned == nil {
+			return err
+		}
+		dv := reflect.ValueOf(dest).Elem()
+		v := reflect.ValueOf(returned)
+		switch {
+		case v.Type().AssignableTo(dv.Type()):
+			dv.Set(v)
+		case v.Type().ConvertibleTo(dv.Type()) && v.Kind() != reflect.String:
+			dv.Set(v.Convert(dv.Type()))
+		default:
+			return xerrors.Errorf("returned value of %s cannot be mapped to %s",
+				v.Type().String(), dv.Type().String())
+		}
+		return nil
+	case DB, Tx:
+		var sql internal.SQL
+		if err := buildSQL(&sql); err != nil {
+			return err
+		}
+
+		rows, err := conn.Query(sql.String())
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			return ErrNoRows
+		}
+		ct, err := rows.ColumnTypes()
+		if err != nil {
+			return err
+		}
+		if len(ct) == 0 {
+			return xerrors.New("no column is selected")
+		}
+		ptrs := make([]interface{}, len(ct))
+		ptrs[0] = dest
+		for i := 1; i < len(ptrs); i++ {
+			ptrs[i] = new(interface{})
+		}
+		return rows.Scan(ptrs...)
+	}
+
+	return xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
+}
+
+// scanScalar scans the first column of the first row into dest.
+func (s *SelectStmt) scanScalar(dest interface{}) error {
+	return s.scalar(s.buildSQL, s, dest)
+}
+
+// scanScalar scans the first column of the first row into dest.
+func (s *rawStmt) scanScalar(dest interface{}) error {
+	return s.scalar(s.buildSQL, s, dest)
+}
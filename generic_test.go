@@ -0,0 +1,216 @@
+package gsorm_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/interfaces/iinsert"
+	"github.com/champon1020/gsorm/interfaces/iupdate"
+	"gotest.tools/v3/assert"
+)
+
+type genericEmployee struct {
+	EmpNo     int
+	FirstName string
+}
+
+func newGenericDB(values [][]interface{}) gsorm.DB {
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}
+	return newFakeDB(newFakeRows(ct, values))
+}
+
+func TestFind(t *testing.T) {
+	db := newGenericDB([][]interface{}{{1001, "Taro"}, {1002, "Jiro"}})
+	employees, err := gsorm.Find[genericEmployee](gsorm.Select(db, "emp_no", "first_name").From("employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []genericEmployee{{EmpNo: 1001, FirstName: "Taro"}, {EmpNo: 1002, FirstName: "Jiro"}}, employees)
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil).From("employees"), []genericEmployee{{EmpNo: 1001, FirstName: "Taro"}})
+	employees, err = gsorm.Find[genericEmployee](gsorm.Select(mock).From("employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []genericEmployee{{EmpNo: 1001, FirstName: "Taro"}}, employees)
+}
+
+func TestOne(t *testing.T) {
+	db := newGenericDB([][]interface{}{{1001, "Taro"}})
+	employee, err := gsorm.One[genericEmployee](gsorm.Select(db).From("employees").Where("emp_no = ?", 1001))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, genericEmployee{EmpNo: 1001, FirstName: "Taro"}, employee)
+	assert.DeepEqual(t, []string{"SELECT * FROM employees WHERE emp_no = 1001 LIMIT 1"}, db.(*fakeDB).queries)
+
+	// The statement which doesn't have First.
+	db = newGenericDB([][]interface{}{{1002, "Jiro"}})
+	employee, err = gsorm.One[genericEmployee](gsorm.RawStmt(db, "SELECT * FROM employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, genericEmployee{EmpNo: 1002, FirstName: "Jiro"}, employee)
+
+	db = newGenericDB([][]interface{}{})
+	_, err = gsorm.One[genericEmployee](gsorm.RawStmt(db, "SELECT * FROM employees"))
+	assert.Equal(t, gsorm.ErrNoRows, err)
+}
+
+func TestScalar(t *testing.T) {
+	newDB := func(v interface{}) gsorm.DB {
+		ct := []gsorm.ExportedIColumnType{newFakeColumn("value", reflect.TypeOf(v))}
+		return newFakeDB(newFakeRows(ct, [][]interface{}{{v}}))
+	}
+
+	n, err := gsorm.Scalar[int64](gsorm.Count(newDB(int64(300024))).From("employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, int64(300024), n)
+
+	s, err := gsorm.Scalar[string](gsorm.RawStmt(newDB("Yamada"), "SELECT MAX(last_name) FROM employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, "Yamada", s)
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Count(nil).From("employees"), 300024)
+	n, err = gsorm.Scalar[int64](gsorm.Count(mock).From("employees"))
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, int64(300024), n)
+}
+
+func TestScalar_Fail(t *testing.T) {
+	ct := []gsorm.ExportedIColumnType{newFakeColumn("value", reflect.TypeOf(int64(0)))}
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{}))
+	_, err := gsorm.Scalar[int64](gsorm.Count(db).From("employees"))
+	assert.Equal(t, gsorm.ErrNoRows, err)
+
+	_, err = gsorm.Scalar[int64](gsorm.Insert(nil, "employees", "emp_no").Values(1001).Returning("emp_no"))
+	assert.Error(t, err, "*gsorm.InsertStmt cannot be used for Scalar")
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Count(nil).From("employees"), "many")
+	_, err = gsorm.Scalar[int64](gsorm.Count(mock).From("employees"))
+	assert.Error(t, err, "returned value of string cannot be mapped to int64")
+}
+
+func TestInsertOf(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+		lastName  string
+	}
+
+	testCases := []struct {
+		Stmt     iinsert.Model
+		Expected string
+	}{
+		{
+			gsorm.InsertOf(nil, "employees", Employee{ID: 1001, FirstName: "Taro", lastName: "Yamada"}),
+			"INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro')",
+		},
+		{
+			gsorm.InsertOf(nil, "employees", []Employee{{ID: 1001, FirstName: "Taro"}, {ID: 1002, FirstName: "Jiro"}}...),
+			"INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro')",
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.(*gsorm.InsertStmt).SQL()
+		errs := testCase.Stmt.(*gsorm.InsertStmt).ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestInsertOf_Fail(t *testing.T) {
+	testCases := []struct {
+		Stmt          iinsert.Model
+		ExpectedError string
+	}{
+		{
+			gsorm.InsertOf(nil, "employees", map[string]interface{}{"emp_no": 1001}),
+			"map[string]interface {} is invalid type for model columns",
+		},
+		{
+			gsorm.InsertOf[genericEmployee](nil, "employees"),
+			"models must not be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.Stmt.Exec()
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
+
+func TestUpdateOf(t *testing.T) {
+	employee := genericEmployee{EmpNo: 1001, FirstName: "Hanako"}
+
+	testCases := []struct {
+		Stmt     iupdate.Where
+		Expected string
+	}{
+		{
+			gsorm.UpdateOf(nil, "employees", &employee).Where("emp_no = ?", 1001),
+			"UPDATE employees SET emp_no = 1001, first_name = 'Hanako' WHERE emp_no = 1001",
+		},
+		{
+			gsorm.UpdateOf(nil, "employees", &employee, "first_name").Where("emp_no = ?", 1001),
+			"UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001",
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.(*gsorm.UpdateStmt).SQL()
+		errs := testCase.Stmt.(*gsorm.UpdateStmt).ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestUpdateOf_Version(t *testing.T) {
+	type Employee struct {
+		EmpNo   int
+		Version int `gsorm:"version"`
+	}
+	model := Employee{EmpNo: 1001, Version: 3}
+	db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	if err := gsorm.UpdateOf(db, "employees", &model).Where("emp_no = ?", 1001).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, 4, model.Version)
+	assert.DeepEqual(t, []string{
+		"UPDATE employees SET emp_no = 1001, version = version + 1 WHERE version = 3 AND (emp_no = 1001)",
+	}, db.execs)
+}
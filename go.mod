@@ -1,20 +1,27 @@
 module github.com/champon1020/gsorm
 
-go 1.15
+go 1.18
 
 require (
 	github.com/go-gorp/gorp v2.2.0+incompatible
 	github.com/go-sql-driver/mysql v1.6.0
 	github.com/google/go-cmp v0.5.6
 	github.com/jmoiron/sqlx v1.3.4
-	github.com/pkg/errors v0.9.1 // indirect
-	github.com/poy/onpar v1.1.2 // indirect
 	github.com/stretchr/testify v1.4.0
-	github.com/ziutek/mymysql v1.5.4 // indirect
-	golang.org/x/sys v0.0.0-20200831180312-196b9ba8737a // indirect
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
-	gopkg.in/yaml.v2 v2.2.7 // indirect
 	gorm.io/driver/mysql v1.1.0
 	gorm.io/gorm v1.21.10
 	gotest.tools/v3 v3.0.2
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.2 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/poy/onpar v1.1.2 // indirect
+	github.com/ziutek/mymysql v1.5.4 // indirect
+	golang.org/x/sys v0.0.0-20200831180312-196b9ba8737a // indirect
+	gopkg.in/yaml.v2 v2.2.7 // indirect
+)
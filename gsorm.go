@@ -2,8 +2,8 @@ package gsorm
 
 import (
 	"database/sql"
-	"fmt"
 
+	"github.com/champon1020/gsorm/expr"
 	"github.com/champon1020/gsorm/interfaces/ialtertable"
 	"github.com/champon1020/gsorm/interfaces/icreatedb"
 	"github.com/champon1020/gsorm/interfaces/icreateindex"
@@ -15,20 +15,31 @@ import (
 	"github.com/champon1020/gsorm/interfaces/iraw"
 	"github.com/champon1020/gsorm/interfaces/iselect"
 	"github.com/champon1020/gsorm/interfaces/iupdate"
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
 )
 
+// ErrNoRows is returned by (*SelectStmt).First when no row is selected.
+// It's same as sql.ErrNoRows.
+var ErrNoRows = sql.ErrNoRows
+
+// ErrStaleObject is returned by (*UpdateStmt).Exec and (*UpdateStmt).Query
+// when the model has the version field and no row is updated,
+// which means the row has been updated or deleted by another transaction.
+var ErrStaleObject = xerrors.New("the object is stale")
+
 // Open opens the database connection.
-func Open(driver, dsn string) (DB, error) {
+func Open(driver, dsn string, opts ...Option) (DB, error) {
 	d, err := sql.Open(driver, dsn)
 	if err != nil {
 		return nil, err
 	}
-	return &db{conn: d}, nil
+	return &db{conn: d, opts: newOptions(internal.DialectOf(driver), opts...)}, nil
 }
 
 // OpenMock opens the mock database connection.
-func OpenMock() MockDB {
-	return &mockDB{}
+func OpenMock(opts ...Option) MockDB {
+	return &mockDB{opts: newOptions(MySQL, opts...)}
 }
 
 // RawStmt calls raw string statement.
@@ -41,94 +52,130 @@ func Select(conn conn, columns ...string) iselect.Stmt {
 	return newSelectStmt(conn, columns...)
 }
 
+// SelectExpr calls SELECT command whose column can be the expression built by expr package.
+//
+//	err := gsorm.SelectExpr(db, "emp_no", expr.Sum("salary").As("total")).From("salaries").GroupBy("emp_no").Query(&model)
+func SelectExpr(conn conn, columns ...interface{}) iselect.Stmt {
+	cols, err := columnsOf(columns)
+	s := newSelectStmt(conn, cols...)
+	if err != nil {
+		s.throw(err)
+	}
+	return s
+}
+
+// SelectModel calls SELECT command with the mapped columns of the model.
+// It's same as gsorm.Select(conn).Columns(model).
+func SelectModel(conn conn, model interface{}) iselect.Stmt {
+	return newSelectStmt(conn).Columns(model)
+}
+
 // Insert calls INSERT command.
 func Insert(conn conn, table string, columns ...string) iinsert.Stmt {
 	return newInsertStmt(conn, table, columns...)
 }
 
+// InsertModel calls INSERT command with the model.
+// The table is the table name of the model and the columns are the mapped columns of the model.
+//
+//	err := gsorm.InsertModel(db, &employees).Exec()
+func InsertModel(conn conn, model interface{}) iinsert.Model {
+	table, err := From(conn, model)
+	cols, colErr := writableColumns(modelType(model))
+	s := newInsertStmt(conn, table, cols...)
+	if err != nil {
+		s.throw(err)
+	}
+	if colErr != nil {
+		s.throw(colErr)
+	}
+	return s.Model(model)
+}
+
 // Update calls UPDATE command.
 func Update(conn conn, table string) iupdate.Stmt {
 	return newUpdateStmt(conn, table)
 }
 
+// UpdateModel calls UPDATE command with the model.
+// The table is the table name of the model.
+// If the columns are empty, they are the mapped columns of the model.
+//
+//	err := gsorm.UpdateModel(db, &employee, "first_name").Where("emp_no = ?", 1001).Exec()
+func UpdateModel(conn conn, model interface{}, columns ...string) iupdate.Model {
+	table, err := From(conn, model)
+	s := newUpdateStmt(conn, table)
+	if err != nil {
+		s.throw(err)
+	}
+	if len(columns) == 0 {
+		cols, err := updatableColumns(modelType(model))
+		if err != nil {
+			s.throw(err)
+		}
+		columns = cols
+	}
+	return s.Model(model, columns...)
+}
+
 // Delete calls DELETE command.
-func Delete(conn conn) idelete.Stmt {
-	return newDeleteStmt(conn)
+// The tables are the target tables of multiple-table DELETE.
+func Delete(conn conn, tables ...string) idelete.Stmt {
+	return newDeleteStmt(conn, tables...)
+}
+
+// DeleteModel calls DELETE command with FROM clause of the table name of the model.
+// If the model has the field tagged with softdelete, it's built as UPDATE statement
+// which sets the current time to the column unless (*DeleteStmt).HardDelete is called.
+//
+//	err := gsorm.DeleteModel(db, &Employee{}).Where("emp_no = ?", 1001).Exec()
+func DeleteModel(conn conn, model interface{}) idelete.From {
+	table, err := From(conn, model)
+	s := newDeleteStmt(conn)
+	if err != nil {
+		s.throw(err)
+	}
+	s.model = model
+	s.softDelete = softDeleteColumn(modelType(model))
+	return s.From(table)
 }
 
 // Count calls COUNT function.
+// If the columns are empty, COUNT(*) is called.
 func Count(conn conn, columns ...string) iselect.Stmt {
-	if len(columns) > 0 {
-		var cols string
-		for i, c := range columns {
-			if i > 0 {
-				cols += ", "
-			}
-			cols += fmt.Sprintf("COUNT(%s)", c)
-		}
-		return newSelectStmt(conn, cols)
+	if len(columns) == 0 {
+		return SelectExpr(conn, expr.Count())
 	}
-	return newSelectStmt(conn)
+	return aggregate(conn, "COUNT", columns)
 }
 
 // Sum calls SUM function.
 func Sum(conn conn, columns ...string) iselect.Stmt {
-	if len(columns) > 0 {
-		var cols string
-		for i, c := range columns {
-			if i > 0 {
-				cols += ", "
-			}
-			cols += fmt.Sprintf("SUM(%s)", c)
-		}
-		return newSelectStmt(conn, cols)
-	}
-	return newSelectStmt(conn)
+	return aggregate(conn, "SUM", columns)
 }
 
 // Avg calls AVG function.
 func Avg(conn conn, columns ...string) iselect.Stmt {
-	if len(columns) > 0 {
-		var cols string
-		for i, c := range columns {
-			if i > 0 {
-				cols += ", "
-			}
-			cols += fmt.Sprintf("AVG(%s)", c)
-		}
-		return newSelectStmt(conn, cols)
-	}
-	return newSelectStmt(conn)
+	return aggregate(conn, "AVG", columns)
 }
 
 // Max calls MAX function.
 func Max(conn conn, columns ...string) iselect.Stmt {
-	if len(columns) > 0 {
-		var cols string
-		for i, c := range columns {
-			if i > 0 {
-				cols += ", "
-			}
-			cols += fmt.Sprintf("MAX(%s)", c)
-		}
-		return newSelectStmt(conn, cols)
-	}
-	return newSelectStmt(conn)
+	return aggregate(conn, "MAX", columns)
 }
 
 // Min calls MIN function.
 func Min(conn conn, columns ...string) iselect.Stmt {
-	if len(columns) > 0 {
-		var cols string
-		for i, c := range columns {
-			if i > 0 {
-				cols += ", "
-			}
-			cols += fmt.Sprintf("MIN(%s)", c)
-		}
-		return newSelectStmt(conn, cols)
+	return aggregate(conn, "MIN", columns)
+}
+
+// aggregate calls SELECT command with the aggregate function of each column.
+func aggregate(conn conn, fn string, columns []string) iselect.Stmt {
+	cols := make([]interface{}, len(columns))
+	for i, c := range columns {
+		cols[i] = expr.Fn(fn, c)
 	}
-	return newSelectStmt(conn)
+	return SelectExpr(conn, cols...)
 }
 
 // AlterTable calls ALTER TABLE command.
@@ -151,6 +198,17 @@ func CreateTable(conn conn, table string) icreatetable.Stmt {
 	return newCreateTableStmt(conn, table)
 }
 
+// CreateTableModel calls CREATE TABLE command with the model.
+// The table is the table name of the model.
+func CreateTableModel(conn conn, model interface{}) icreatetable.Model {
+	table, err := From(conn, model)
+	s := newCreateTableStmt(conn, table)
+	if err != nil {
+		s.throw(err)
+	}
+	return s.Model(model)
+}
+
 // DropDB calls DROP DATABASE command.
 func DropDB(conn conn, dbName string) idropdb.Stmt {
 	return newDropDBStmt(conn, dbName)
@@ -0,0 +1,130 @@
+package gsorm
+
+import (
+	"context"
+	"reflect"
+)
+
+// BeforeInserter is implemented by the model which is called before it's inserted by (*InsertStmt).Model.
+// If BeforeInsert returns error, the statement is not executed.
+type BeforeInserter interface {
+	BeforeInsert(ctx context.Context) error
+}
+
+// AfterInserter is implemented by the model which is called after it's inserted by (*InsertStmt).Model.
+type AfterInserter interface {
+	AfterInsert(ctx context.Context) error
+}
+
+// BeforeUpdater is implemented by the model which is called before it's updated by (*UpdateStmt).Model.
+// If BeforeUpdate returns error, the statement is not executed.
+type BeforeUpdater interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdater is implemented by the model which is called after it's updated by (*UpdateStmt).Model.
+type AfterUpdater interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleter is implemented by the model which is called before it's deleted by gsorm.DeleteModel.
+// If BeforeDelete returns error, the statement is not executed.
+type BeforeDeleter interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleter is implemented by the model which is called after it's deleted by gsorm.DeleteModel.
+type AfterDeleter interface {
+	AfterDelete(ctx context.Context) error
+}
+
+// AfterFinder is implemented by the model which is called after the selected rows are mapped to it.
+type AfterFinder interface {
+	AfterFind(ctx context.Context) error
+}
+
+// txKey is the key of the transaction stored in the context of hooks.
+type txKey struct{}
+
+// TxFromContext returns the transaction in which the statement calling the hook is executed.
+// If the statement is not executed in the transaction, it returns false.
+//
+//	func (e *Employee) AfterInsert(ctx context.Context) error {
+//		tx, ok := gsorm.TxFromContext(ctx)
+//		if !ok {
+//			return nil
+//		}
+//		return gsorm.InsertModel(tx, &Salary{EmpNo: e.EmpNo, Salary: 60000}).Exec()
+//	}
+func TxFromContext(ctx context.Context) (Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(Tx)
+	return tx, ok
+}
+
+// hookContext returns the context which is passed to the hooks.
+func hookContext(conn conn) context.Context {
+	ctx := context.Background()
+	if tx, ok := conn.(Tx); ok {
+		ctx = context.WithValue(ctx, txKey{}, tx)
+	}
+	return ctx
+}
+
+// execWithHooks calls the before hooks of the model, run and the after hooks in order.
+// The conn is the connection in which run executes the statement, and it's passed to the hooks through the context.
+// If the statement has errors, or the before hooks or run returns error, the rest are not called.
+func execWithHooks[B, A any](s *stmt, conn conn, model interface{},
+	before func(B, context.Context) error, after func(A, context.Context) error, run func() error) error {
+	if len(s.errors) > 0 {
+		return s.errors[0]
+	}
+	if err := runHooks(conn, model, before); err != nil {
+		return err
+	}
+	if err := run(); err != nil {
+		return err
+	}
+	return runHooks(conn, model, after)
+}
+
+// runHooks calls the hook for every struct in the model which implements H.
+// The model is the struct, the slice or array of struct, or the pointer of them.
+func runHooks[H any](conn conn, model interface{}, hook func(H, context.Context) error) error {
+	if model == nil {
+		return nil
+	}
+	ctx := hookContext(conn)
+	return walkModel(reflect.ValueOf(model), func(v reflect.Value) error {
+		if h, ok := v.Interface().(H); ok {
+			return hook(h, ctx)
+		}
+		return nil
+	})
+}
+
+// walkModel calls fn with every struct in the model.
+// If the struct is addressable, fn is called with its pointer so that the hooks can modify it.
+func walkModel(v reflect.Value, fn func(reflect.Value) error) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+			return fn(v)
+		}
+		return walkModel(v.Elem(), fn)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := walkModel(v.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if v.CanAddr() {
+			return fn(v.Addr())
+		}
+		return fn(v)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package gsorm_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/champon1020/gsorm"
+	"gotest.tools/v3/assert"
+)
+
+type hookEmployee struct {
+	EmpNo     int
+	FirstName string
+	calls     []string
+}
+
+func (e *hookEmployee) TableName() string {
+	return "employees"
+}
+
+func (e *hookEmployee) BeforeInsert(ctx context.Context) error {
+	if e.FirstName == "" {
+		return errors.New("first_name must not be empty")
+	}
+	e.calls = append(e.calls, "BeforeInsert")
+	return nil
+}
+
+func (e *hookEmployee) AfterInsert(ctx context.Context) error {
+	e.calls = append(e.calls, "AfterInsert")
+	tx, ok := gsorm.TxFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return gsorm.Insert(tx, "salaries", "emp_no", "salary").Values(e.EmpNo, 60000).Exec()
+}
+
+func (e *hookEmployee) BeforeUpdate(ctx context.Context) error {
+	e.calls = append(e.calls, "BeforeUpdate")
+	return nil
+}
+
+func (e *hookEmployee) AfterUpdate(ctx context.Context) error {
+	e.calls = append(e.calls, "AfterUpdate")
+	return nil
+}
+
+func (e *hookEmployee) BeforeDelete(ctx context.Context) error {
+	if e.EmpNo == 0 {
+		return errors.New("emp_no must not be zero")
+	}
+	e.calls = append(e.calls, "BeforeDelete")
+	return nil
+}
+
+func (e *hookEmployee) AfterDelete(ctx context.Context) error {
+	e.calls = append(e.calls, "AfterDelete")
+	return nil
+}
+
+func (e *hookEmployee) AfterFind(ctx context.Context) error {
+	e.calls = append(e.calls, "AfterFind")
+	return nil
+}
+
+type timeHookEmployee struct {
+	EmpNo     int
+	CreatedAt time.Time `gsorm:"autocreatetime"`
+	UpdatedAt time.Time `gsorm:"autoupdatetime"`
+	filled    []bool
+}
+
+func (e *timeHookEmployee) TableName() string {
+	return "employees"
+}
+
+func (e *timeHookEmployee) BeforeInsert(ctx context.Context) error {
+	e.filled = append(e.filled, !e.CreatedAt.IsZero())
+	return nil
+}
+
+func (e *timeHookEmployee) BeforeUpdate(ctx context.Context) error {
+	e.filled = append(e.filled, !e.UpdatedAt.IsZero())
+	return nil
+}
+
+func TestInsertStmt_Hooks(t *testing.T) {
+	db := newFakeDBWithResult(newFakeResult(0, 2)).(*fakeDB)
+	employees := []hookEmployee{{EmpNo: 1001, FirstName: "Taro"}, {EmpNo: 1002, FirstName: "Jiro"}}
+	if err := gsorm.InsertModel(db, &employees).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	for _, e := range employees {
+		assert.DeepEqual(t, []string{"BeforeInsert", "AfterInsert"}, e.calls)
+	}
+	assert.DeepEqual(t, []string{
+		"INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro')",
+	}, db.execs)
+
+	// The statement is not executed if the before hook returns error.
+	db = newFakeDBWithResult(newFakeResult(0, 2)).(*fakeDB)
+	employees = []hookEmployee{{EmpNo: 1001, FirstName: "Taro"}, {EmpNo: 1002}}
+	err := gsorm.InsertModel(db, &employees).Exec()
+	assert.Error(t, err, "first_name must not be empty")
+	assert.Equal(t, 0, len(db.execs))
+}
+
+func TestInsertStmt_Hooks_Tx(t *testing.T) {
+	mock := gsorm.OpenMock()
+	mocktx := mock.ExpectBegin()
+	mocktx.Expect(gsorm.InsertModel(nil, &hookEmployee{EmpNo: 1001, FirstName: "Taro"}))
+	mocktx.Expect(gsorm.Insert(nil, "salaries", "emp_no", "salary").Values(1001, 60000))
+	mocktx.ExpectCommit()
+
+	tx, err := mock.Begin()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	employee := hookEmployee{EmpNo: 1001, FirstName: "Taro"}
+	if err := gsorm.InsertModel(tx, &employee).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []string{"BeforeInsert", "AfterInsert"}, employee.calls)
+}
+
+func TestInsertStmt_Hooks_BatchTx(t *testing.T) {
+	db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	employees := []hookEmployee{{EmpNo: 1001, FirstName: "Taro"}, {EmpNo: 1002, FirstName: "Jiro"}}
+	if err := gsorm.InsertModel(db, &employees).Batch(1).InTransaction().Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	// The hooks are called inside the transaction of the batched statements.
+	assert.DeepEqual(t, []string{
+		"INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro')",
+		"INSERT INTO employees (emp_no, first_name) VALUES (1002, 'Jiro')",
+		"INSERT INTO salaries (emp_no, salary) VALUES (1001, 60000)",
+		"INSERT INTO salaries (emp_no, salary) VALUES (1002, 60000)",
+	}, db.execs)
+	assert.Equal(t, true, db.tx.committed)
+
+	// The transaction is rolled back if the before hook returns error.
+	db = newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	employees = []hookEmployee{{EmpNo: 1001, FirstName: "Taro"}, {EmpNo: 1002}}
+	err := gsorm.InsertModel(db, &employees).Batch(1).InTransaction().Exec()
+	assert.Error(t, err, "first_name must not be empty")
+	assert.Equal(t, 0, len(db.execs))
+	assert.Equal(t, true, db.tx.rolledBack)
+	assert.Equal(t, false, db.tx.committed)
+}
+
+func TestStatement_Hooks_BeforeTimestamps(t *testing.T) {
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+	db := &gsorm.ExportedClockDB{DB: newFakeDBWithResult(newFakeResult(0, 1)), Clock: func() time.Time { return now }}
+
+	// The timestamps are filled after the before hooks are called.
+	employee := timeHookEmployee{EmpNo: 1001}
+	if err := gsorm.InsertModel(db, &employee).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []bool{false}, employee.filled)
+	assert.Equal(t, now, employee.CreatedAt)
+
+	employee = timeHookEmployee{EmpNo: 1001}
+	if err := gsorm.UpdateModel(db, &employee).Where("emp_no = ?", 1001).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []bool{false}, employee.filled)
+	assert.Equal(t, now, employee.UpdatedAt)
+}
+
+func TestUpdateStmt_Hooks(t *testing.T) {
+	db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	employee := hookEmployee{EmpNo: 1001, FirstName: "Hanako"}
+	if err := gsorm.UpdateModel(db, &employee, "first_name").Where("emp_no = ?", 1001).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []string{"BeforeUpdate", "AfterUpdate"}, employee.calls)
+	assert.DeepEqual(t, []string{"UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001"}, db.execs)
+}
+
+func TestDeleteStmt_Hooks(t *testing.T) {
+	db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	employee := hookEmployee{EmpNo: 1001}
+	if err := gsorm.DeleteModel(db, &employee).Where("emp_no = ?", 1001).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []string{"BeforeDelete", "AfterDelete"}, employee.calls)
+	assert.DeepEqual(t, []string{"DELETE FROM employees WHERE emp_no = 1001"}, db.execs)
+
+	db = newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	err := gsorm.DeleteModel(db, &hookEmployee{}).Where("emp_no = ?", 1001).Exec()
+	assert.Error(t, err, "emp_no must not be zero")
+	assert.Equal(t, 0, len(db.execs))
+}
+
+func TestSelectStmt_AfterFind(t *testing.T) {
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}
+
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001, "Taro"}, {1002, "Jiro"}}))
+	var employees []hookEmployee
+	if err := gsorm.Select(db).From("employees").Query(&employees); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, 2, len(employees))
+	for _, e := range employees {
+		assert.DeepEqual(t, []string{"AfterFind"}, e.calls)
+	}
+
+	db = newFakeDB(newFakeRows(ct, [][]interface{}{{1001, "Taro"}}))
+	var employee hookEmployee
+	if err := gsorm.Select(db).From("employees").First(&employee); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, 1001, employee.EmpNo)
+	assert.DeepEqual(t, []string{"AfterFind"}, employee.calls)
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil).From("employees"), []*hookEmployee{{EmpNo: 1001, FirstName: "Taro"}})
+	var ptrs []*hookEmployee
+	if err := gsorm.Select(mock).From("employees").Query(&ptrs); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []string{"AfterFind"}, ptrs[0].calls)
+}
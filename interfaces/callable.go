@@ -1,5 +1,7 @@
 package interfaces
 
+import "github.com/champon1020/gsorm/explain"
+
 // QueryCallable is embedded into clause interfaces which can call (*Stmt).Query.
 type QueryCallable interface {
 	Query(model interface{}) error
@@ -12,6 +14,11 @@ type ExecCallable interface {
 	Stmt
 }
 
+// Explainer is embedded into clause interfaces which can call (*Stmt).Explain.
+type Explainer interface {
+	Explain(opts ...explain.Option) (*explain.Plan, error)
+}
+
 // MigrateCallable is embedded into clause interfaces which can call (*MigStmt).Migration.
 type MigrateCallable interface {
 	Migrate() error
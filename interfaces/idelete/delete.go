@@ -13,16 +13,66 @@ type RawClause interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	From(tables ...string) From
 	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // From is interface which is returned by (*Stmt).From.
 type From interface {
+	HardDelete() From
 	RawClause(raw string, values ...interface{}) RawClause
+	Join(table string) Join
+	LeftJoin(table string) Join
+	RightJoin(table string) Join
+	Using(tables ...string) Using
 	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Join is interface which is returned by (*Stmt).Join.
+type Join interface {
+	On(expr string, values ...interface{}) On
+}
+
+// On is interface which is returned by (*Stmt).On.
+type On interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Join(table string) Join
+	LeftJoin(table string) Join
+	RightJoin(table string) Join
+	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Using is interface which is returned by (*Stmt).Using.
+type Using interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Where is interface which is returned by (*Stmt).Where.
@@ -30,19 +80,59 @@ type Where interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Scope is interface which is passed to the functions of (*DeleteStmt).Scopes and (*DeleteStmt).When.
+// WHERE clause which is called after another condition is built as AND clause.
+type Scope interface {
+	Where(expr string, values ...interface{}) Scope
+	WhereModel(model interface{}, zeroColumns ...string) Scope
 }
 
 // And is interface which is returned by (*Stmt).And.
 type And interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Or is interface which is returned by (*Or).Or.
 type Or interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Or(expr string, values ...interface{}) Or
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// OrderBy is interface which is returned by (*Stmt).OrderBy.
+type OrderBy interface {
+	Limit(limit int) Limit
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Limit is interface which is returned by (*Stmt).Limit.
+type Limit interface {
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Returning is interface which is returned by (*DeleteStmt).Returning.
+type Returning interface {
+	interfaces.QueryCallable
 }
@@ -16,22 +16,72 @@ type Stmt interface {
 type RawClause interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Values(values ...interface{}) Values
+	OnConflict(columns ...string) OnConflict
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Model is interface which is returned by (*InsertStmt).Model.
 type Model interface {
+	Batch(size int) Batch
+	OnConflict(columns ...string) OnConflict
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Batch is interface which is returned by (*InsertStmt).Batch.
+type Batch interface {
+	InTransaction() Batch
+	OnConflict(columns ...string) OnConflict
+	ExecBatch() (int64, error)
+	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Select is interface which is returned by (*InsertStmt).Select.
 type Select interface {
+	OnConflict(columns ...string) OnConflict
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Values is interface which is returned by (*InsertStmt).Values.
 type Values interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Values(values ...interface{}) Values
+	OnConflict(columns ...string) OnConflict
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// OnConflict is interface which is returned by (*InsertStmt).OnConflict.
+type OnConflict interface {
+	DoNothing() DoNothing
+	DoUpdate(column string, value interface{}) DoUpdate
+	UpdateFromExcluded(columns ...string) DoUpdate
+}
+
+// DoNothing is interface which is returned by (*InsertStmt).DoNothing.
+type DoNothing interface {
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// DoUpdate is interface which is returned by (*InsertStmt).DoUpdate and (*InsertStmt).UpdateFromExcluded.
+type DoUpdate interface {
+	DoUpdate(column string, value interface{}) DoUpdate
+	UpdateFromExcluded(columns ...string) DoUpdate
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Returning is interface which is returned by (*InsertStmt).Returning.
+type Returning interface {
+	interfaces.QueryCallable
 }
@@ -6,5 +6,6 @@ import "github.com/champon1020/gsorm/interfaces"
 type Stmt interface {
 	interfaces.QueryCallable
 	interfaces.ExecCallable
+	interfaces.Explainer
 	interfaces.MigrateCallable
 }
@@ -4,58 +4,109 @@ import (
 	"github.com/champon1020/gsorm/interfaces"
 )
 
+// QueryCallable is embedded into clause interfaces which can call (*SelectStmt).Query and the other terminators.
+type QueryCallable interface {
+	Exists() (bool, error)
+	Pluck(column string, model interface{}) error
+	Int64() (int64, error)
+	Float64() (float64, error)
+	StringValue() (string, error)
+	interfaces.QueryCallable
+	interfaces.Explainer
+}
+
+// PaginateCallable is embedded into clause interfaces which can call (*SelectStmt).Paginate and (*SelectStmt).First.
+// They are not callable after LIMIT clause since they add LIMIT clause.
+type PaginateCallable interface {
+	Paginate(model interface{}, page, size int) (int64, error)
+	First(model interface{}) error
+	QueryCallable
+}
+
 // Stmt is interface which is returned by gsorm.Select.
 type Stmt interface {
+	Columns(model interface{}) Stmt
+	Unscoped() Stmt
+	Preload(associations ...string) Stmt
 	RawClause(raw string, values ...interface{}) RawClause
 	From(tables ...string) From
+	FromModel(model interface{}) From
 }
 
 // RawClause is interface which is returned by (*Stmt).RawClause.
 type RawClause interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	From(tables ...string) From
+	FromModel(model interface{}) From
 	Join(table string) Join
 	LeftJoin(table string) Join
 	RightJoin(table string) Join
+	FullJoin(table string) Join
+	CrossJoin(table string) From
+	NaturalJoin(table string) From
+	JoinSub(stmt interfaces.Stmt, alias string) Join
+	LeftJoinSub(stmt interfaces.Stmt, alias string) Join
+	JoinLateral(stmt interfaces.Stmt, alias string) Join
+	LeftJoinLateral(stmt interfaces.Stmt, alias string) Join
 	On(expr string, values ...interface{}) On
+	Using(columns ...string) On
+	AndOn(expr string, values ...interface{}) On
+	OrOn(expr string, values ...interface{}) On
 	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
 	GroupBy(columns ...string) GroupBy
+	GroupByExpr(columns ...interface{}) GroupBy
 	Having(expr string, values ...interface{}) Having
 	Union(stmt interfaces.Stmt) Union
 	UnionAll(stmt interfaces.Stmt) Union
+	Intersect(stmt interfaces.Stmt) Union
+	IntersectAll(stmt interfaces.Stmt) Union
+	Except(stmt interfaces.Stmt) Union
+	ExceptAll(stmt interfaces.Stmt) Union
 	OrderBy(columns ...string) OrderBy
+	OrderByExpr(columns ...interface{}) OrderBy
 	Limit(limit int) Limit
 	Offset(offset int) Offset
-	interfaces.QueryCallable
+	ForUpdate() Lock
+	ForShare() Lock
+	PaginateCallable
 }
 
-// From is interface which is returned by (*SelectStmt).From.
+// From is interface which is returned by (*SelectStmt).From, (*SelectStmt).CrossJoin and (*SelectStmt).NaturalJoin.
 type From interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Join(table string) Join
 	LeftJoin(table string) Join
 	RightJoin(table string) Join
-	On
-	interfaces.QueryCallable
+	FullJoin(table string) Join
+	CrossJoin(table string) From
+	NaturalJoin(table string) From
+	JoinSub(stmt interfaces.Stmt, alias string) Join
+	LeftJoinSub(stmt interfaces.Stmt, alias string) Join
+	JoinLateral(stmt interfaces.Stmt, alias string) Join
+	LeftJoinLateral(stmt interfaces.Stmt, alias string) Join
+	Where(expr string, values ...interface{}) Where
+	Where
+	PaginateCallable
 }
 
 // Join is interface which is returned by (*SelectStmt).Join.
 type Join interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	On(expr string, values ...interface{}) On
+	Using(columns ...string) On
 }
 
-// On is interface which is returned by (*SelectStmt).On.
+// On is interface which is returned by (*SelectStmt).On and (*SelectStmt).Using.
+// The join condition can be extended by AndOn and OrOn only after ON or USING clause.
 type On interface {
-	RawClause(raw string, values ...interface{}) RawClause
-	Join(table string) Join
-	LeftJoin(table string) Join
-	RightJoin(table string) Join
-	Where(expr string, values ...interface{}) Where
-	Where
-	interfaces.QueryCallable
+	AndOn(expr string, values ...interface{}) On
+	OrOn(expr string, values ...interface{}) On
+	From
 }
 
 // Where is interface which is returned by (*SelectStmt).Where.
@@ -63,8 +114,18 @@ type Where interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
 	And
-	interfaces.QueryCallable
+	PaginateCallable
+}
+
+// Scope is interface which is passed to the functions of (*SelectStmt).Scopes and (*SelectStmt).When.
+// WHERE clause which is called after another condition is built as AND clause.
+type Scope interface {
+	Where(expr string, values ...interface{}) Scope
+	WhereModel(model interface{}, zeroColumns ...string) Scope
 }
 
 // And is interface which is returned by (*SelectStmt).And.
@@ -72,8 +133,11 @@ type And interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
 	GroupBy(columns ...string) GroupBy
+	GroupByExpr(columns ...interface{}) GroupBy
+	ForUpdate() Lock
+	ForShare() Lock
 	GroupBy
-	interfaces.QueryCallable
+	PaginateCallable
 }
 
 // Or is interface which is returned by (*SelectStmt).Or.
@@ -81,8 +145,11 @@ type Or interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Or(expr string, values ...interface{}) Or
 	GroupBy(columns ...string) GroupBy
+	GroupByExpr(columns ...interface{}) GroupBy
+	ForUpdate() Lock
+	ForShare() Lock
 	GroupBy
-	interfaces.QueryCallable
+	PaginateCallable
 }
 
 // GroupBy is interface which is returned by (*SelectStmt).GroupBy.
@@ -90,7 +157,7 @@ type GroupBy interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Having(expr string, values ...interface{}) Having
 	Having
-	interfaces.QueryCallable
+	PaginateCallable
 }
 
 // Having is interface which is returned by (*SelectStmt).Having.
@@ -98,37 +165,87 @@ type Having interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Union(stmt interfaces.Stmt) Union
 	UnionAll(stmt interfaces.Stmt) Union
+	Intersect(stmt interfaces.Stmt) Union
+	IntersectAll(stmt interfaces.Stmt) Union
+	Except(stmt interfaces.Stmt) Union
+	ExceptAll(stmt interfaces.Stmt) Union
 	Union
-	interfaces.QueryCallable
+	PaginateCallable
 }
 
-// Union is interface which is returned by (*SelectStmt).Union.
+// Union is interface which is returned by (*SelectStmt).Union and the other set operations.
+// OrderBy, Limit and Offset which are called after Union are applied to the combined result.
 type Union interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Union(stmt interfaces.Stmt) Union
 	UnionAll(stmt interfaces.Stmt) Union
+	Intersect(stmt interfaces.Stmt) Union
+	IntersectAll(stmt interfaces.Stmt) Union
+	Except(stmt interfaces.Stmt) Union
+	ExceptAll(stmt interfaces.Stmt) Union
 	OrderBy(columns ...string) OrderBy
+	OrderByExpr(columns ...interface{}) OrderBy
 	OrderBy
-	interfaces.QueryCallable
+	PaginateCallable
 }
 
 // OrderBy is interface which is returned by (*SelectStmt).OrderBy.
 type OrderBy interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	OrderBy(columns ...string) OrderBy
+	OrderByExpr(columns ...interface{}) OrderBy
+	SeekAfter(cursor string) SeekAfter
 	Limit(limit int) Limit
-	interfaces.QueryCallable
+	ForUpdate() Lock
+	ForShare() Lock
+	PaginateCallable
+}
+
+// SeekAfter is interface which is returned by (*SelectStmt).SeekAfter.
+type SeekAfter interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Limit(limit int) Limit
+	ForUpdate() Lock
+	ForShare() Lock
+	QueryCallable
 }
 
 // Limit is interface which is returned by (*SelectStmt).Limit.
 type Limit interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Offset(int) Offset
-	interfaces.QueryCallable
+	ForUpdate() Lock
+	ForShare() Lock
+	QueryCallable
 }
 
 // Offset is interface which is returned by (*SelectStmt).Offset.
 type Offset interface {
 	RawClause(raw string, values ...interface{}) RawClause
-	interfaces.QueryCallable
+	ForUpdate() Lock
+	ForShare() Lock
+	QueryCallable
+}
+
+// Lock is interface which is returned by (*SelectStmt).ForUpdate and (*SelectStmt).ForShare.
+type Lock interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Of(tables ...string) Of
+	NoWait() NoWait
+	SkipLocked() NoWait
+	QueryCallable
+}
+
+// Of is interface which is returned by (*SelectStmt).Of.
+type Of interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	NoWait() NoWait
+	SkipLocked() NoWait
+	QueryCallable
+}
+
+// NoWait is interface which is returned by (*SelectStmt).NoWait and (*SelectStmt).SkipLocked.
+type NoWait interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	QueryCallable
 }
@@ -7,6 +7,9 @@ import (
 // Stmt is interface which is returned by gsorm.Update.
 type Stmt interface {
 	RawClause(raw string, values ...interface{}) RawClause
+	Join(table string) Join
+	LeftJoin(table string) Join
+	RightJoin(table string) Join
 	Model(model interface{}, columns ...string) Model
 	Set(column string, value interface{}) Set
 }
@@ -16,22 +19,68 @@ type RawClause interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Set(column string, value interface{}) Set
 	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
 }
 
+// Join is interface which is returned by (*UpdateStmt).Join.
+type Join interface {
+	On(expr string, values ...interface{}) On
+}
+
+// On is interface which is returned by (*UpdateStmt).On.
+type On interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Join(table string) Join
+	LeftJoin(table string) Join
+	RightJoin(table string) Join
+	Model(model interface{}, columns ...string) Model
+	Set(column string, value interface{}) Set
+}
+
 // Model is interface which is returned by (*UpdateStmt).Model.
 type Model interface {
+	From(tables ...string) From
 	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Set is interface which is returned by (*UpdateStmt).Set.
 type Set interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Set(column string, value interface{}) Set
+	From(tables ...string) From
 	Where(epxr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// From is interface which is returned by (*UpdateStmt).From.
+type From interface {
+	RawClause(raw string, values ...interface{}) RawClause
+	Where(expr string, values ...interface{}) Where
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Where is interface which is returned by (*UpdateStmt).Where.
@@ -39,19 +88,59 @@ type Where interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
 	Or(expr string, values ...interface{}) Or
+	Scopes(scopes ...func(Scope) Scope) Where
+	When(cond bool, fn func(Scope) Scope) Where
+	WhereModel(model interface{}, zeroColumns ...string) Where
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Scope is interface which is passed to the functions of (*UpdateStmt).Scopes and (*UpdateStmt).When.
+// WHERE clause which is called after another condition is built as AND clause.
+type Scope interface {
+	Where(expr string, values ...interface{}) Scope
+	WhereModel(model interface{}, zeroColumns ...string) Scope
 }
 
 // And is interface which is returned by (*UpdateStmt).And.
 type And interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	And(expr string, values ...interface{}) And
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
 	interfaces.ExecCallable
+	interfaces.Explainer
 }
 
 // Or is interface which is returned by (*UpdateStmt).Or.
 type Or interface {
 	RawClause(raw string, values ...interface{}) RawClause
 	Or(expr string, values ...interface{}) Or
+	OrderBy(columns ...string) OrderBy
+	Limit(limit int) Limit
+	Returning(columns ...string) Returning
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// OrderBy is interface which is returned by (*UpdateStmt).OrderBy.
+type OrderBy interface {
+	Limit(limit int) Limit
 	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Limit is interface which is returned by (*UpdateStmt).Limit.
+type Limit interface {
+	interfaces.ExecCallable
+	interfaces.Explainer
+}
+
+// Returning is interface which is returned by (*UpdateStmt).Returning.
+type Returning interface {
+	interfaces.QueryCallable
 }
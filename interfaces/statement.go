@@ -1,5 +1,7 @@
 package interfaces
 
+import "github.com/champon1020/gsorm/fingerprint"
+
 // Clause is interface for SQL clauses.
 type Clause interface {
 	String() string
@@ -20,3 +22,14 @@ type Stmt interface {
 	Cmd() Clause
 	CompareWith(s Stmt) error
 }
+
+// Fingerprinter is the interface for statements which return the fingerprint of SQL.
+type Fingerprinter interface {
+	Fingerprint() (fingerprint.Fingerprint, error)
+}
+
+// Expr is the interface for SQL expressions like function call and CASE expression.
+type Expr interface {
+	// Expr returns the expression with placeholders and the values which are assigned to them.
+	Expr() (string, []interface{})
+}
@@ -0,0 +1,29 @@
+package internal
+
+// Dialect is the SQL dialect of the database.
+type Dialect string
+
+// SQL dialects.
+const (
+	MySQL      Dialect = "mysql"
+	MySQL5     Dialect = "mysql5"
+	PostgreSQL Dialect = "postgres"
+	SQLite     Dialect = "sqlite3"
+)
+
+// DialectOf returns the dialect which corresponds to the driver name.
+// If the driver is unknown, it returns MySQL.
+func DialectOf(driver string) Dialect {
+	switch driver {
+	case "postgres", "pgx":
+		return PostgreSQL
+	case "sqlite3", "sqlite":
+		return SQLite
+	}
+	return MySQL
+}
+
+// IsMySQL reports whether the dialect is MySQL or older MySQL.
+func (d Dialect) IsMySQL() bool {
+	return d == MySQL || d == MySQL5
+}
@@ -0,0 +1,33 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectOf(t *testing.T) {
+	testCases := []struct {
+		Driver string
+		Result internal.Dialect
+	}{
+		{"mysql", internal.MySQL},
+		{"postgres", internal.PostgreSQL},
+		{"pgx", internal.PostgreSQL},
+		{"sqlite3", internal.SQLite},
+		{"sqlite", internal.SQLite},
+		{"unknown", internal.MySQL},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.Result, internal.DialectOf(testCase.Driver))
+	}
+}
+
+func TestDialect_IsMySQL(t *testing.T) {
+	assert.Equal(t, true, internal.MySQL.IsMySQL())
+	assert.Equal(t, true, internal.MySQL5.IsMySQL())
+	assert.Equal(t, false, internal.PostgreSQL.IsMySQL())
+	assert.Equal(t, false, internal.SQLite.IsMySQL())
+}
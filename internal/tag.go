@@ -15,6 +15,33 @@ type Tag struct {
 	FK      string
 	Ref     string
 	UC      string
+
+	// SoftDelete reports whether the column stores the time when the row is soft deleted.
+	SoftDelete bool
+
+	// AutoCreateTime and AutoUpdateTime report whether the column is filled with the current time
+	// when the row is inserted and updated respectively.
+	AutoCreateTime bool
+	AutoUpdateTime bool
+
+	// Version reports whether the column stores the version of the row for optimistic locking.
+	Version bool
+
+	// Relation is the kind of association which is one of "hasone", "hasmany", "belongsto" and "many2many",
+	// and Association is its name which is given to Preload.
+	Relation    string
+	Association string
+
+	// References is the column which is referred by FK of association.
+	// If it's empty, the column of the same name as FK is referred.
+	References string
+
+	// JoinTable is the join table of many2many association, and JoinRef is its column which refers to the associated table.
+	JoinTable string
+	JoinRef   string
+
+	// Prefix is the prefix of the columns which are mapped to the fields of the nested struct.
+	Prefix string
 }
 
 // Lookup returns tag exists or not.
@@ -34,6 +61,22 @@ func (t *Tag) Lookup(tag string) bool {
 		return t.FK != "" && t.Ref != ""
 	case "uc":
 		return t.UC != ""
+	case "softdelete":
+		return t.SoftDelete
+	case "autocreatetime":
+		return t.AutoCreateTime
+	case "autoupdatetime":
+		return t.AutoUpdateTime
+	case "version":
+		return t.Version
+	case "association":
+		return t.Relation != ""
+	case "references":
+		return t.References != ""
+	case "join":
+		return t.JoinTable != "" && t.JoinRef != ""
+	case "prefix":
+		return t.Prefix != ""
 	}
 	return false
 }
@@ -50,13 +93,32 @@ func ExtractTags(t reflect.Type) []*Tag {
 // ExtractTag extracts the struct field tag.
 func ExtractTag(f reflect.StructField) *Tag {
 	t := &Tag{}
-	jsonTag := f.Tag.Get("json")
-	if jsonTag != "" {
+	// The name of json tag like "first_name,omitempty" is used as the column name
+	// unless gsorm tag has the column name.
+	jsonTag := strings.Split(f.Tag.Get("json"), ",")[0]
+	if jsonTag != "" && jsonTag != "-" {
 		t.Column = jsonTag
 	}
 
 	tags := strings.Split(f.Tag.Get("gsorm"), ",")
 	for _, v := range tags {
+		if v == "" {
+			continue
+		}
+		switch v {
+		case "softdelete":
+			t.SoftDelete = true
+			continue
+		case "autocreatetime":
+			t.AutoCreateTime = true
+			continue
+		case "autoupdatetime":
+			t.AutoUpdateTime = true
+			continue
+		case "version":
+			t.Version = true
+			continue
+		}
 		if !strings.Contains(v, "=") {
 			t.Column = v
 			continue
@@ -82,6 +144,19 @@ func ExtractTag(f reflect.StructField) *Tag {
 			}
 		case "uc":
 			t.UC = eq[1]
+		case "hasone", "hasmany", "belongsto", "many2many":
+			t.Relation = eq[0]
+			t.Association = eq[1]
+		case "references":
+			t.References = eq[1]
+		case "join":
+			join := strings.Split(eq[1], ":")
+			t.JoinTable = join[0]
+			if len(join) == 2 {
+				t.JoinRef = join[1]
+			}
+		case "prefix":
+			t.Prefix = eq[1]
 		}
 	}
 	return t
@@ -10,8 +10,16 @@ import (
 )
 
 type TagModel struct {
-	A string `gsorm:"col,typ=VARCHAR(64),notnull=t,default='test',pk=PK_a,fk=FK_a:reftbl(refcol),uc=UC_a"`
-	B string `gsorm:"col" json:"col2"`
+	A string   `gsorm:"col,typ=VARCHAR(64),notnull=t,default='test',pk=PK_a,fk=FK_a:reftbl(refcol),uc=UC_a"`
+	B string   `gsorm:"col" json:"col2"`
+	C string   `json:"col3,omitempty"`
+	D string   `gsorm:"deleted_at,softdelete"`
+	E int64    `gsorm:"autocreatetime,autoupdatetime"`
+	F int      `gsorm:"lock_version,version"`
+	G []int    `gsorm:"hasmany=Salaries,fk=emp_no"`
+	H []int    `gsorm:"many2many=Departments,fk=emp_no,join=dept_emp:dept_no"`
+	I struct{} `gsorm:"prefix=dept_"`
+	J struct{} `gsorm:"belongsto=Manager,fk=manager_no,references=emp_no"`
 }
 
 func TestTag_Lookup(t *testing.T) {
@@ -24,6 +32,19 @@ func TestTag_Lookup(t *testing.T) {
 		FK:      "FK_a",
 		Ref:     "reftbl(refcol)",
 		UC:      "UC_a",
+
+		SoftDelete:     true,
+		AutoCreateTime: true,
+		AutoUpdateTime: true,
+		Version:        true,
+
+		Relation:    "many2many",
+		Association: "Departments",
+		JoinTable:   "dept_emp",
+		JoinRef:     "dept_no",
+		References:  "emp_no",
+
+		Prefix: "dept_",
 	}
 	assert.Equal(t, true, tag.Lookup("col"))
 	assert.Equal(t, true, tag.Lookup("typ"))
@@ -32,6 +53,14 @@ func TestTag_Lookup(t *testing.T) {
 	assert.Equal(t, true, tag.Lookup("pk"))
 	assert.Equal(t, true, tag.Lookup("fk"))
 	assert.Equal(t, true, tag.Lookup("uc"))
+	assert.Equal(t, true, tag.Lookup("softdelete"))
+	assert.Equal(t, true, tag.Lookup("autocreatetime"))
+	assert.Equal(t, true, tag.Lookup("autoupdatetime"))
+	assert.Equal(t, true, tag.Lookup("version"))
+	assert.Equal(t, true, tag.Lookup("association"))
+	assert.Equal(t, true, tag.Lookup("references"))
+	assert.Equal(t, true, tag.Lookup("join"))
+	assert.Equal(t, true, tag.Lookup("prefix"))
 	assert.Equal(t, false, tag.Lookup("hoge"))
 }
 
@@ -48,6 +77,14 @@ func Test_ExtractTags(t *testing.T) {
 			UC:      "UC_a",
 		},
 		{Column: "col"},
+		{Column: "col3"},
+		{Column: "deleted_at", SoftDelete: true},
+		{AutoCreateTime: true, AutoUpdateTime: true},
+		{Column: "lock_version", Version: true},
+		{FK: "emp_no", Relation: "hasmany", Association: "Salaries"},
+		{FK: "emp_no", Relation: "many2many", Association: "Departments", JoinTable: "dept_emp", JoinRef: "dept_no"},
+		{Prefix: "dept_"},
+		{FK: "manager_no", Relation: "belongsto", Association: "Manager", References: "emp_no"},
 	}
 
 	tags := internal.ExtractTags(reflect.TypeOf(TagModel{}))
@@ -78,6 +115,66 @@ func Test_ExtractTag(t *testing.T) {
 				Column: "col",
 			},
 		},
+		{
+			2,
+			&internal.Tag{
+				Column: "col3",
+			},
+		},
+		{
+			3,
+			&internal.Tag{
+				Column:     "deleted_at",
+				SoftDelete: true,
+			},
+		},
+		{
+			4,
+			&internal.Tag{
+				AutoCreateTime: true,
+				AutoUpdateTime: true,
+			},
+		},
+		{
+			5,
+			&internal.Tag{
+				Column:  "lock_version",
+				Version: true,
+			},
+		},
+		{
+			6,
+			&internal.Tag{
+				FK:          "emp_no",
+				Relation:    "hasmany",
+				Association: "Salaries",
+			},
+		},
+		{
+			7,
+			&internal.Tag{
+				FK:          "emp_no",
+				Relation:    "many2many",
+				Association: "Departments",
+				JoinTable:   "dept_emp",
+				JoinRef:     "dept_no",
+			},
+		},
+		{
+			8,
+			&internal.Tag{
+				Prefix: "dept_",
+			},
+		},
+		{
+			9,
+			&internal.Tag{
+				FK:          "manager_no",
+				Relation:    "belongsto",
+				Association: "Manager",
+				References:  "emp_no",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
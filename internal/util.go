@@ -2,6 +2,7 @@ package internal
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"strings"
@@ -25,6 +26,21 @@ func SnakeCase(s string) string {
 	return buf.String()
 }
 
+// Pluralize converts the singular noun in English to plural form.
+// It's done by the simple rules like "salary" -> "salaries" and "address" -> "addresses".
+func Pluralize(s string) string {
+	switch {
+	case s == "":
+		return s
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !strings.ContainsRune("aeiou", rune(s[len(s)-2])):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "z"),
+		strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	}
+	return s + "s"
+}
+
 // ToStringOpt is the option of ToString.
 type ToStringOpt struct {
 	Quotes       bool
@@ -40,24 +56,61 @@ type ToStringOpt struct {
 //  1.0 (floatN)                            -> "1.00000"
 //  true (bool)                             -> "1" (If false, "0")
 //  nil                                     -> "nil"
+//  nil (pointer)                           -> "NULL"
+//  &v (pointer)                            -> same as v
+//  v (driver.Valuer)                       -> same as the returned value of v.Value() (If nil, "NULL")
+// The value of named type like "type Status int" is converted in the same way as its underlying type.
+// It's only for the debug string like String of the clauses, so the value whose Value of driver.Valuer
+// returns error is shown in the default format. FormatValue is used to build SQL.
 func ToString(v interface{}, opt *ToStringOpt) string {
+	s, err := FormatValue(v, opt)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
+
+// FormatValue converts the type of value to string in the same way as ToString.
+// It returns the error if Value of driver.Valuer returns error.
+func FormatValue(v interface{}, opt *ToStringOpt) (string, error) {
 	if v == nil {
-		return "nil"
+		return "nil", nil
 	}
 
 	if opt == nil {
 		opt = &ToStringOpt{Quotes: true}
 	}
 
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return "NULL", nil
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		dv, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+		if dv == nil {
+			return "NULL", nil
+		}
+		if b, ok := dv.([]byte); ok {
+			dv = string(b)
+		}
+		return FormatValue(dv, opt)
+	}
+	if rv.Kind() == reflect.Ptr {
+		return FormatValue(rv.Elem().Interface(), opt)
+	}
+
 	switch v := v.(type) {
 	case string:
 		if opt.Quotes {
-			return fmt.Sprintf("'%s'", v)
+			return fmt.Sprintf("'%s'", v), nil
 		}
 		if opt.DoubleQuotes {
-			return fmt.Sprintf(`"%s"`, v)
+			return fmt.Sprintf(`"%s"`, v), nil
 		}
-		return v
+		return v, nil
 	case int,
 		int8,
 		int16,
@@ -68,39 +121,52 @@ func ToString(v interface{}, opt *ToStringOpt) string {
 		uint16,
 		uint32,
 		uint64:
-		return fmt.Sprintf("%d", v)
+		return fmt.Sprintf("%d", v), nil
 	case float32, float64:
-		return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%v", v), "0"), ".")
+		return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%v", v), "0"), "."), nil
 	case bool:
 		if v {
-			return "1"
+			return "1", nil
 		}
-		return "0"
+		return "0", nil
 	case time.Time:
 		t := v.Format("2006-01-02 15:04:05")
 		if opt.Quotes {
-			return fmt.Sprintf("'%s'", t)
+			return fmt.Sprintf("'%s'", t), nil
 		}
 		if opt.DoubleQuotes {
-			return fmt.Sprintf(`"%s"`, t)
+			return fmt.Sprintf(`"%s"`, t), nil
 		}
-		return t
+		return t, nil
 	}
 
-	typ := reflect.TypeOf(v).Kind()
-	if typ == reflect.Slice || typ == reflect.Array {
+	switch rv.Kind() {
+	case reflect.String:
+		return FormatValue(rv.String(), opt)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return FormatValue(rv.Int(), opt)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FormatValue(rv.Uint(), opt)
+	case reflect.Float32, reflect.Float64:
+		return FormatValue(rv.Float(), opt)
+	case reflect.Bool:
+		return FormatValue(rv.Bool(), opt)
+	case reflect.Slice, reflect.Array:
 		var s string
-		vals := reflect.ValueOf(v)
-		for i := 0; i < vals.Len(); i++ {
+		for i := 0; i < rv.Len(); i++ {
 			if i != 0 {
 				s += ", "
 			}
-			s += ToString(vals.Index(i).Interface(), opt)
+			e, err := FormatValue(rv.Index(i).Interface(), opt)
+			if err != nil {
+				return "", err
+			}
+			s += e
 		}
-		return s
+		return s, nil
 	}
 
-	return fmt.Sprintf("%s", v)
+	return fmt.Sprintf("%s", v), nil
 }
 
 // ColumnsAndFields generates map of column index and field index.
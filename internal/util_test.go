@@ -1,7 +1,11 @@
 package internal_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +31,26 @@ func TestSnakeCase(t *testing.T) {
 	}
 }
 
+func TestPluralize(t *testing.T) {
+	testCases := []struct {
+		String string
+		Result string
+	}{
+		{"employee", "employees"},
+		{"salary", "salaries"},
+		{"day", "days"},
+		{"address", "addresses"},
+		{"box", "boxes"},
+		{"branch", "branches"},
+		{"dept_emp", "dept_emps"},
+		{"", ""},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.Result, internal.Pluralize(testCase.String))
+	}
+}
+
 func TestToString_Quotes(t *testing.T) {
 	var (
 		i   int     = 1
@@ -219,6 +243,67 @@ func TestToStringWituhoutQuotes(t *testing.T) {
 	}
 }
 
+type status int
+
+type upperString string
+
+func (s upperString) Value() (driver.Value, error) {
+	return []byte(strings.ToUpper(string(s))), nil
+}
+
+func TestToString_Valuer(t *testing.T) {
+	var (
+		i   = 1001
+		tm  = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+		nip *int
+	)
+
+	testCases := []struct {
+		Value  interface{}
+		Result string
+	}{
+		{&i, "1001"},
+		{&tm, `'2006-01-02 15:04:05'`},
+		{nip, "NULL"},
+		{status(2), "2"},
+		{upperString("taro"), `'TARO'`},
+		{sql.NullString{String: "Taro", Valid: true}, `'Taro'`},
+		{sql.NullString{}, "NULL"},
+		{&sql.NullInt64{Int64: 10, Valid: true}, "10"},
+		{[]interface{}{&i, nip}, "1001, NULL"},
+	}
+
+	for _, testCase := range testCases {
+		res := internal.ToString(testCase.Value, nil)
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+type invalidValue string
+
+func (v invalidValue) Value() (driver.Value, error) {
+	return nil, errors.New("invalid value")
+}
+
+func TestFormatValue_Fail(t *testing.T) {
+	testCases := []interface{}{
+		invalidValue("x"),
+		[]interface{}{1001, invalidValue("x")},
+	}
+
+	for _, testCase := range testCases {
+		_, err := internal.FormatValue(testCase, nil)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, "invalid value", err.Error())
+	}
+
+	// ToString falls back to the default format.
+	assert.Equal(t, "x", internal.ToString(invalidValue("x"), nil))
+}
+
 func TestColumnsAndFields(t *testing.T) {
 	type Model1 struct {
 		ID        int
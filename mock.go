@@ -18,6 +18,27 @@ type mockDB struct {
 
 	// How many times transaction has begun.
 	txItr int
+
+	// Options of the mock database.
+	opts options
+}
+
+// dialect returns the SQL dialect of the mock database.
+func (m *mockDB) dialect() Dialect {
+	if m.opts.dialect == "" {
+		return MySQL
+	}
+	return m.opts.dialect
+}
+
+// clock returns the clock of the mock database.
+func (m *mockDB) clock() Clock {
+	return m.opts.clock
+}
+
+// namingStrategy returns the naming strategy of the mock database.
+func (m *mockDB) namingStrategy() NamingStrategy {
+	return m.opts.namingStrategy
 }
 
 // Ping is dummy function.
@@ -150,6 +171,21 @@ type mockTx struct {
 	expected []expectation
 }
 
+// dialect returns the SQL dialect of the parent mock database.
+func (m *mockTx) dialect() Dialect {
+	return dialectOf(m.db)
+}
+
+// clock returns the clock of the parent mock database.
+func (m *mockTx) clock() Clock {
+	return clockOf(m.db)
+}
+
+// namingStrategy returns the naming strategy of the parent mock database.
+func (m *mockTx) namingStrategy() NamingStrategy {
+	return namingStrategyOf(m.db)
+}
+
 // Ping is dummy function.
 func (m *mockTx) Ping() error {
 	return nil
@@ -2,6 +2,7 @@ package gsorm_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/champon1020/gsorm"
 	"github.com/google/go-cmp/cmp"
@@ -194,6 +195,48 @@ func TestMockDB_CompareWith(t *testing.T) {
 		// Validate if the expected error was occurred.
 		assert.EqualError(t, err, expectedErr)
 	}
+	{
+		expectedErr := "models comparison was failed:\n" +
+			"expected: map[column1:10]\n" +
+			"actual:   map[column1:100]\n"
+
+		// Test phase.
+		mock := gsorm.OpenMock()
+		mock.Expect(gsorm.Insert(nil, "table1", "column1").Model(&map[string]interface{}{"column1": 10}))
+
+		// Actual process.
+		err := gsorm.Insert(mock, "table1", "column1").Model(&map[string]interface{}{"column1": 100}).Exec()
+
+		// Validate if the expected error was occurred.
+		assert.EqualError(t, err, expectedErr)
+	}
+}
+
+func TestMockDB_CompareWith_AutoTime(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+		UpdatedAt time.Time `gsorm:"autoupdatetime"`
+	}
+
+	// Test phase.
+	mock := gsorm.OpenMock()
+	mock.Expect(gsorm.InsertModel(nil, &Employee{EmpNo: 1001}))
+	mock.Expect(gsorm.UpdateModel(nil, &Employee{EmpNo: 1001}).Where("emp_no = ?", 1001))
+
+	// Actual process.
+	if err := gsorm.InsertModel(mock, &Employee{EmpNo: 1001, CreatedAt: time.Now()}).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	if err := gsorm.UpdateModel(mock, &Employee{EmpNo: 1001, UpdatedAt: time.Now()}).
+		Where("emp_no = ?", 1001).Exec(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+
+	// Validate if the expected options were executed.
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
 }
 
 func TestMockTx_DummyFunctions(t *testing.T) {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax/clause"
 	"golang.org/x/xerrors"
 )
 
@@ -53,13 +55,17 @@ func (p *createTableModelParser) Parse() (*internal.SQL, error) {
 	}
 
 	sql.Write("(")
-	for i := 0; i < p.modelType.NumField(); i++ {
-		if i > 0 {
-			sql.Write(",")
-		}
-
+	for i, n := 0, 0; i < p.modelType.NumField(); i++ {
 		p.f = p.modelType.Field(i)
 		p.tag = internal.ExtractTag(p.f)
+		// The associations are not the columns.
+		if p.tag.Lookup("association") {
+			continue
+		}
+		if n > 0 {
+			sql.Write(",")
+		}
+		n++
 
 		column := p.ParseColumn(&sql)
 
@@ -161,7 +167,7 @@ type insertModelParser struct {
 	model       reflect.Value
 	modelType   reflect.Type
 	Cols        []string
-	ColumnField map[int]int
+	ColumnField map[int][]int
 }
 
 // newInsertModelParser creates insertModelParser instance.
@@ -186,7 +192,9 @@ func (p *insertModelParser) Parse() (*internal.SQL, error) {
 	switch p.modelType.Kind() {
 	case reflect.Slice, reflect.Array:
 		if p.modelType.Elem().Kind() == reflect.Struct {
-			p.ParseStructSlice(&sql, p.model)
+			if err := p.ParseStructSlice(&sql, p.model); err != nil {
+				return nil, err
+			}
 			return &sql, nil
 		}
 		if p.modelType.Elem().Kind() == reflect.Map {
@@ -196,7 +204,9 @@ func (p *insertModelParser) Parse() (*internal.SQL, error) {
 			return &sql, nil
 		}
 	case reflect.Struct:
-		p.ParseStruct(&sql, p.model)
+		if err := p.ParseStruct(&sql, p.model); err != nil {
+			return nil, err
+		}
 		return &sql, nil
 	case reflect.Map:
 		if err := p.ParseMap(&sql, p.model); err != nil {
@@ -222,14 +232,17 @@ func (p *insertModelParser) ParseMapSlice(sql *internal.SQL, model reflect.Value
 }
 
 // ParseStructSlice parses slice or array of struct to SQL.
-func (p *insertModelParser) ParseStructSlice(sql *internal.SQL, model reflect.Value) {
+func (p *insertModelParser) ParseStructSlice(sql *internal.SQL, model reflect.Value) error {
 	p.ColumnField = p.columnsAndFields(model.Type().Elem())
 	for i := 0; i < model.Len(); i++ {
 		if i > 0 {
 			sql.Write(",")
 		}
-		p.ParseStruct(sql, model.Index(i))
+		if err := p.ParseStruct(sql, model.Index(i)); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 // ParseMap parses map to SQL.
@@ -243,7 +256,10 @@ func (p *insertModelParser) ParseMap(sql *internal.SQL, model reflect.Value) err
 		if !v.IsValid() {
 			return xerrors.New("column names must be included in one of map keys")
 		}
-		s := internal.ToString(v.Interface(), nil)
+		s, err := internal.FormatValue(v.Interface(), nil)
+		if err != nil {
+			return err
+		}
 		sql.Write(s)
 	}
 	sql.Write(")")
@@ -251,7 +267,7 @@ func (p *insertModelParser) ParseMap(sql *internal.SQL, model reflect.Value) err
 }
 
 // ParseStruct parses struct to SQL.
-func (p *insertModelParser) ParseStruct(sql *internal.SQL, model reflect.Value) {
+func (p *insertModelParser) ParseStruct(sql *internal.SQL, model reflect.Value) error {
 	if p.ColumnField == nil {
 		p.ColumnField = p.columnsAndFields(model.Type())
 	}
@@ -261,27 +277,18 @@ func (p *insertModelParser) ParseStruct(sql *internal.SQL, model reflect.Value)
 			sql.Write(",")
 		}
 		opt := &internal.ToStringOpt{Quotes: true}
-		s := internal.ToString(model.Field(p.ColumnField[i]).Interface(), opt)
+		s, err := internal.FormatValue(fieldValueByIndex(model, p.ColumnField[i]).Interface(), opt)
+		if err != nil {
+			return err
+		}
 		sql.Write(s)
 	}
 	sql.Write(")")
+	return nil
 }
 
-func (p *insertModelParser) columnsAndFields(target reflect.Type) map[int]int {
-	cf := make(map[int]int)
-	for i, col := range p.Cols {
-		for j := 0; j < target.NumField(); j++ {
-			c := internal.ExtractTag(target.Field(j)).Column
-			if c == "" {
-				c = internal.SnakeCase(target.Field(j).Name)
-			}
-			if col != c {
-				continue
-			}
-			cf[i] = j
-		}
-	}
-	return cf
+func (p *insertModelParser) columnsAndFields(target reflect.Type) map[int][]int {
+	return columnsAndFields(p.Cols, target)
 }
 
 // updateModelParser is the model parser for update statement.
@@ -289,7 +296,7 @@ type updateModelParser struct {
 	model       reflect.Value
 	modelType   reflect.Type
 	Cols        []string
-	ColumnField map[int]int
+	ColumnField map[int][]int
 }
 
 // newUpdateModelParser creates updateModelParser instance.
@@ -313,7 +320,16 @@ func (p *updateModelParser) Parse() (*internal.SQL, error) {
 
 	switch p.modelType.Kind() {
 	case reflect.Struct:
-		p.ParseStruct(&sql, p.model)
+		// The fields tagged with autoupdatetime are updated even if they are not included in Cols.
+		p.Cols = appendTaggedColumns(p.Cols, p.modelType, func(tag *internal.Tag) bool {
+			return tag.AutoUpdateTime
+		})
+		p.Cols = appendTaggedColumns(p.Cols, p.modelType, func(tag *internal.Tag) bool {
+			return tag.Version
+		})
+		if err := p.ParseStruct(&sql, p.model); err != nil {
+			return nil, err
+		}
 		return &sql, nil
 	case reflect.Map:
 		if err := p.ParseMap(&sql, p.model); err != nil {
@@ -335,14 +351,17 @@ func (p *updateModelParser) ParseMap(sql *internal.SQL, model reflect.Value) err
 		if !v.IsValid() {
 			return xerrors.New("column names must be included in one of map keys")
 		}
-		s := internal.ToString(v.Interface(), nil)
+		s, err := internal.FormatValue(v.Interface(), nil)
+		if err != nil {
+			return err
+		}
 		sql.Write(fmt.Sprintf("%s = %s", c, s))
 	}
 	return nil
 }
 
 // ParseStruct parses struct to SQL.
-func (p *updateModelParser) ParseStruct(sql *internal.SQL, model reflect.Value) {
+func (p *updateModelParser) ParseStruct(sql *internal.SQL, model reflect.Value) error {
 	if p.ColumnField == nil {
 		p.ColumnField = p.columnsAndFields(model.Type())
 	}
@@ -350,25 +369,234 @@ func (p *updateModelParser) ParseStruct(sql *internal.SQL, model reflect.Value)
 		if i > 0 {
 			sql.Write(",")
 		}
+		// The version is incremented by the database.
+		if internal.ExtractTag(model.Type().FieldByIndex(p.ColumnField[i])).Version {
+			sql.Write(fmt.Sprintf("%s = %s + 1", p.Cols[i], p.Cols[i]))
+			continue
+		}
 		opt := &internal.ToStringOpt{Quotes: true}
-		s := internal.ToString(model.Field(p.ColumnField[i]).Interface(), opt)
+		s, err := internal.FormatValue(fieldValueByIndex(model, p.ColumnField[i]).Interface(), opt)
+		if err != nil {
+			return err
+		}
 		sql.Write(fmt.Sprintf("%s = %s", p.Cols[i], s))
 	}
+	return nil
+}
+
+func (p *updateModelParser) columnsAndFields(target reflect.Type) map[int][]int {
+	return columnsAndFields(p.Cols, target)
+}
+
+// columnsAndFields returns the map from the index of column to the index sequence of the field which is mapped to it.
+// The fields of embedded struct and nested struct tagged with prefix are mapped in the same way as rowsParser.
+func columnsAndFields(cols []string, target reflect.Type) map[int][]int {
+	fields := structFields(target, "", "", nil)
+	cf := make(map[int][]int)
+	for i, col := range cols {
+		if index := matchField(fields, col); index != nil {
+			cf[i] = index
+		}
+	}
+	return cf
+}
+
+// touchModel sets the current time of the clock to the fields tagged with autocreatetime and autoupdatetime.
+// It's called once before the statement is executed, so that building SQL never modifies the model.
+func touchModel(conn conn, model interface{}, update bool) {
+	if model == nil {
+		return
+	}
+	now := clockOf(conn)()
+	_ = walkModel(reflect.ValueOf(model), func(v reflect.Value) error {
+		fillTimestamps(reflect.Indirect(v), now, update)
+		return nil
+	})
 }
 
-func (p *updateModelParser) columnsAndFields(target reflect.Type) map[int]int {
-	cf := make(map[int]int)
-	for i, col := range p.Cols {
-		for j := 0; j < target.NumField(); j++ {
-			c := internal.ExtractTag(target.Field(j)).Column
-			if c == "" {
-				c = internal.SnakeCase(target.Field(j).Name)
+// fillTimestamps sets the time to the fields tagged with autocreatetime and autoupdatetime.
+// On insert, both fields are set only if they are zero value.
+// On update, only the fields tagged with autoupdatetime are set.
+func fillTimestamps(model reflect.Value, now time.Time, update bool) {
+	for _, f := range tableFields(model.Type()) {
+		v := fieldByIndex(model, f.index, true)
+		if !v.IsValid() {
+			continue
+		}
+		switch {
+		case update && f.tag.AutoUpdateTime:
+		case !update && (f.tag.AutoCreateTime || f.tag.AutoUpdateTime) && v.IsZero():
+		default:
+			continue
+		}
+		setTime(v, now)
+	}
+}
+
+// setTime sets the time to the field of time.Time or the integer which stores unix time.
+// If the field is the pointer of them, the pointer is allocated.
+func setTime(v reflect.Value, now time.Time) {
+	if !v.CanSet() {
+		return
+	}
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(v.Type().Elem())
+		setTime(ptr.Elem(), now)
+		v.Set(ptr)
+		return
+	}
+	timeType := reflect.TypeOf(now)
+	switch {
+	case v.Type() == timeType:
+		v.Set(reflect.ValueOf(now))
+	case v.Kind() >= reflect.Int && v.Kind() <= reflect.Int64:
+		v.SetInt(now.Unix())
+	case v.Kind() >= reflect.Uint && v.Kind() <= reflect.Uint64:
+		v.SetUint(uint64(now.Unix()))
+	}
+}
+
+// appendTaggedColumns appends the columns of the fields whose tags satisfy the condition and which are not included in cols.
+func appendTaggedColumns(cols []string, typ reflect.Type, cond func(*internal.Tag) bool) []string {
+	appended := append([]string{}, cols...)
+	for _, f := range tableFields(typ) {
+		if !cond(f.tag) {
+			continue
+		}
+		found := false
+		for _, col := range appended {
+			if col == f.column {
+				found = true
 			}
-			if col != c {
+		}
+		if !found {
+			appended = append(appended, f.column)
+		}
+	}
+	return appended
+}
+
+// versionOf returns the field tagged with version and its column.
+// If the model is not the pointer of struct or such field doesn't exist, it returns invalid value.
+func versionOf(model interface{}) (reflect.Value, string, error) {
+	v := reflect.ValueOf(model)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, "", nil
+	}
+	v = v.Elem()
+	for _, f := range tableFields(v.Type()) {
+		if !f.tag.Version {
+			continue
+		}
+		// The version of the nil embedded struct is not checked since it cannot be incremented.
+		fv := fieldByIndex(v, f.index, false)
+		if !fv.IsValid() {
+			return reflect.Value{}, "", nil
+		}
+		switch fv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return reflect.Value{}, "", xerrors.Errorf("%s is invalid type for version", fv.Type().String())
+		}
+		return fv, f.column, nil
+	}
+	return reflect.Value{}, "", nil
+}
+
+// incrementVersion increments the version field returned by versionOf.
+func incrementVersion(version reflect.Value) {
+	switch version.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		version.SetInt(version.Int() + 1)
+	default:
+		version.SetUint(version.Uint() + 1)
+	}
+}
+
+// whereModelOf builds WHERE clause from the exported fields of the struct model.
+// Each field is built as equality condition and they are joined with AND.
+// The fields of zero value are skipped unless their columns are included in zeroColumns.
+// The slice fields are built as IN condition, and nil pointer fields are built as IS NULL condition.
+// If no field is used, it returns nil.
+func whereModelOf(model interface{}, zeroColumns []string, d Dialect) (*clause.Where, error) {
+	mv := reflect.Indirect(reflect.ValueOf(model))
+	if mv.Kind() != reflect.Struct {
+		return nil, xerrors.Errorf("%s is invalid type for WhereModel", mv.Kind().String())
+	}
+
+	zero := make(map[string]bool)
+	for _, c := range zeroColumns {
+		zero[c] = true
+	}
+
+	var (
+		conds  []string
+		values []interface{}
+	)
+	for _, f := range tableFields(mv.Type()) {
+		col := f.column
+		if col == "-" {
+			continue
+		}
+
+		v := fieldValueByIndex(mv, f.index)
+		if v.IsZero() && !zero[col] {
+			continue
+		}
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				conds = append(conds, fmt.Sprintf("%s IS NULL", col))
 				continue
 			}
-			cf[i] = j
+			v = v.Elem()
 		}
+
+		if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8 {
+			// IN clause with the empty list is invalid, so the empty slice is skipped.
+			if v.Len() == 0 {
+				continue
+			}
+			list := make([]interface{}, v.Len())
+			for j := 0; j < v.Len(); j++ {
+				list[j] = escapeValue(v.Index(j), d)
+			}
+			conds = append(conds, fmt.Sprintf("%s IN (?)", col))
+			values = append(values, list)
+			continue
+		}
+		conds = append(conds, fmt.Sprintf("%s = ?", col))
+		values = append(values, escapeValue(v, d))
 	}
-	return cf
+
+	if len(conds) == 0 {
+		return nil, nil
+	}
+	return &clause.Where{Expr: strings.Join(conds, " AND "), Values: values}, nil
+}
+
+// escapeValue returns the value whose string is escaped.
+// The value of the named string type is converted to string so that it's quoted.
+func escapeValue(v reflect.Value, d Dialect) interface{} {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return escapeString(v.String(), d)
+	}
+	return v.Interface()
+}
+
+// softDeleteColumn returns the column of the field tagged with softdelete.
+// If the type is not struct or such field doesn't exist, it returns empty string.
+func softDeleteColumn(typ reflect.Type) string {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return ""
+	}
+	for _, f := range tableFields(typ) {
+		if f.tag.SoftDelete {
+			return f.column
+		}
+	}
+	return ""
 }
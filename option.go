@@ -0,0 +1,112 @@
+package gsorm
+
+import (
+	"time"
+
+	"github.com/champon1020/gsorm/internal"
+)
+
+// Dialect is the SQL dialect of the database.
+type Dialect = internal.Dialect
+
+// SQL dialects.
+// MySQL5 is MySQL 5.7 or older which doesn't support FOR SHARE, NOWAIT and SKIP LOCKED.
+const (
+	MySQL      = internal.MySQL
+	MySQL5     = internal.MySQL5
+	PostgreSQL = internal.PostgreSQL
+	SQLite     = internal.SQLite
+)
+
+// Option is the option of the database connection.
+type Option func(*options)
+
+// Clock returns the current time.
+// It's used to fill the columns tagged with autocreatetime and autoupdatetime.
+type Clock func() time.Time
+
+// options stores the configuration of the database connection.
+type options struct {
+	dialect        Dialect
+	clock          Clock
+	namingStrategy NamingStrategy
+}
+
+// WithDialect sets the SQL dialect.
+// By default, the dialect is determined by the driver name.
+func WithDialect(d Dialect) Option {
+	return func(o *options) {
+		o.dialect = d
+	}
+}
+
+// WithClock sets the clock which is used to fill the columns tagged with autocreatetime and autoupdatetime.
+// It's useful to make the time deterministic in tests. By default, time.Now is used.
+func WithClock(c Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// WithNamingStrategy sets the naming strategy which derives the table name from the name of struct.
+// By default, DefaultNamingStrategy is used.
+func WithNamingStrategy(ns NamingStrategy) Option {
+	return func(o *options) {
+		o.namingStrategy = ns
+	}
+}
+
+// newOptions creates options instance with the default dialect.
+func newOptions(d Dialect, opts ...Option) options {
+	o := options{dialect: d}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// dialector is implemented by the connection which knows its dialect.
+type dialector interface {
+	dialect() Dialect
+}
+
+// dialectOf returns the dialect of the connection.
+// If the connection doesn't know its dialect, it returns MySQL.
+func dialectOf(c conn) Dialect {
+	if d, ok := c.(dialector); ok {
+		return d.dialect()
+	}
+	return MySQL
+}
+
+// clocker is implemented by the connection which has its clock.
+type clocker interface {
+	clock() Clock
+}
+
+// clockOf returns the clock of the connection.
+// If the connection doesn't have its clock, it returns time.Now.
+func clockOf(c conn) Clock {
+	if cl, ok := c.(clocker); ok {
+		if clock := cl.clock(); clock != nil {
+			return clock
+		}
+	}
+	return time.Now
+}
+
+// namer is implemented by the connection which has its naming strategy.
+type namer interface {
+	namingStrategy() NamingStrategy
+}
+
+// namingStrategyOf returns the naming strategy of the connection.
+// If the connection doesn't have its naming strategy, it returns DefaultNamingStrategy.
+func namingStrategyOf(c conn) NamingStrategy {
+	if n, ok := c.(namer); ok {
+		if ns := n.namingStrategy(); ns != nil {
+			return ns
+		}
+	}
+	return DefaultNamingStrategy
+}
@@ -2,7 +2,10 @@ package gsorm
 
 import (
 	"database/sql"
+	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/champon1020/gsorm/internal"
 	"golang.org/x/xerrors"
@@ -25,6 +28,9 @@ type rowsParser struct {
 	// Type of the model.
 	modelType reflect.Type
 
+	// Whether any row is scanned.
+	scanned bool
+
 	// Error.
 	err error
 }
@@ -69,16 +75,28 @@ func (p *rowsParser) Next() bool {
 		p.err = err
 		return false
 	}
+	p.scanned = true
 	return true
 }
 
 // Parse converts sql.Rows to reflect.Value.
 func (p *rowsParser) Parse() (*reflect.Value, error) {
+	// The type implementing sql.Scanner is scanned as one value.
+	if p.modelType.Kind() != reflect.Ptr && reflect.PtrTo(p.modelType).Implements(scannerType) {
+		return p.ParseVar()
+	}
+
 	switch p.modelType.Kind() {
+	case reflect.Ptr:
+		return p.ParsePtr()
 	case reflect.Slice,
 		reflect.Array:
-		// If the type of item is struct.
-		if p.modelType.Elem().Kind() == reflect.Struct {
+		// If the type of item is struct or pointer of struct.
+		elem := p.modelType.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if isNestedStruct(elem) {
 			return p.ParseStructSlice()
 		}
 
@@ -90,6 +108,9 @@ func (p *rowsParser) Parse() (*reflect.Value, error) {
 		// If the type of item is predeclared types.
 		return p.ParseSlice()
 	case reflect.Struct:
+		if !isNestedStruct(p.modelType) {
+			return p.ParseVar()
+		}
 		return p.ParseStruct()
 	case reflect.Map:
 		return p.ParseMap()
@@ -138,17 +159,43 @@ func (p *rowsParser) ParseMapSlice() (*reflect.Value, error) {
 	return &sl, nil
 }
 
-// ParseStructSlice converts the slice or array of struct to reflect.Value.
+// ParsePtr converts the pointer to reflect.Value.
+// If no row is scanned, it's nil.
+func (p *rowsParser) ParsePtr() (*reflect.Value, error) {
+	elem := *p
+	elem.modelType = p.modelType.Elem()
+	v, err := elem.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	ptr := reflect.New(p.modelType).Elem()
+	if elem.scanned {
+		ptr.Set(reflect.New(elem.modelType))
+		ptr.Elem().Set(*v)
+	}
+	return &ptr, nil
+}
+
+// ParseStructSlice converts the slice or array of struct or pointer of struct to reflect.Value.
 // dest is the destination type. In this case, underlying type of dest is struct.
 func (p *rowsParser) ParseStructSlice() (*reflect.Value, error) {
-	item := reflect.New(p.modelType.Elem()).Elem()
-	cf := p.columnsAndFields(p.modelType.Elem())
-	for i := 0; i < p.numOfColumns; i++ {
-		p.itemPtr[i] = item.Field(cf[i]).Addr().Interface()
+	elem := p.modelType.Elem()
+	isPtr := elem.Kind() == reflect.Ptr
+	if isPtr {
+		elem = elem.Elem()
 	}
+	item := reflect.New(elem).Elem()
+	p.setItemPtr(item)
 
 	sl := reflect.New(p.modelType).Elem()
 	for p.Next() {
+		if isPtr {
+			ptr := reflect.New(elem)
+			ptr.Elem().Set(item)
+			sl = reflect.Append(sl, ptr)
+			continue
+		}
 		sl = reflect.Append(sl, item)
 	}
 
@@ -200,10 +247,7 @@ func (p *rowsParser) ParseMap() (*reflect.Value, error) {
 // ParseStruct converts struct to reflect.Value.
 func (p *rowsParser) ParseStruct() (*reflect.Value, error) {
 	item := reflect.New(p.modelType).Elem()
-	cf := p.columnsAndFields(p.modelType)
-	for i := 0; i < p.numOfColumns; i++ {
-		p.itemPtr[i] = item.Field(cf[i]).Addr().Interface()
-	}
+	p.setItemPtr(item)
 	p.Next()
 	return &item, nil
 }
@@ -220,23 +264,216 @@ func (p *rowsParser) ParseVar() (*reflect.Value, error) {
 	return &item, nil
 }
 
-func (p *rowsParser) columnsAndFields(dest reflect.Type) map[int]int {
-	cf := make(map[int]int)
+// Merge sets the parsed value v to dest.
+// If dest is struct or slice of struct which has the same length as v,
+// only the fields corresponding to the columns are set and the other fields are kept.
+func (p *rowsParser) Merge(dest reflect.Value, v reflect.Value) {
+	switch {
+	case isNestedStruct(dest.Type()):
+		for _, index := range p.columnsAndFields(dest.Type()) {
+			fieldByIndex(dest, index, true).Set(fieldValueByIndex(v, index))
+		}
+	case dest.Kind() == reflect.Slice &&
+		isNestedStruct(dest.Type().Elem()) &&
+		dest.Len() == v.Len():
+		for i := 0; i < dest.Len(); i++ {
+			p.Merge(dest.Index(i), v.Index(i))
+		}
+	default:
+		dest.Set(v)
+	}
+}
+
+// setItemPtr sets the pointers of the fields of the struct item which are mapped to the columns.
+// The column which is not mapped to any field is scanned and discarded.
+func (p *rowsParser) setItemPtr(item reflect.Value) {
+	cf := p.columnsAndFields(item.Type())
+	for i := 0; i < p.numOfColumns; i++ {
+		index, ok := cf[i]
+		if !ok {
+			p.itemPtr[i] = new(interface{})
+			continue
+		}
+		p.itemPtr[i] = fieldByIndex(item, index, true).Addr().Interface()
+	}
+}
+
+// columnsAndFields returns the map from the index of column to the index sequence of the field which is mapped to it.
+// If several fields are mapped to the same column, the shallowest one is used like the promoted fields of Go.
+func (p *rowsParser) columnsAndFields(dest reflect.Type) map[int][]int {
+	fields := structFields(dest, "", "", nil)
+	cf := make(map[int][]int)
 	for i, ct := range p.columnTypes {
-		for j := 0; j < dest.NumField(); j++ {
-			c := internal.ExtractTag(dest.Field(j)).Column
-			if c == "" {
-				c = internal.SnakeCase(dest.Field(j).Name)
-			}
-			if ct.Name() != c {
+		name := ct.Name()
+		index := matchField(fields, name)
+		// The table-qualified column like "e.emp_no" is also mapped to the field of "emp_no".
+		if index == nil && strings.Contains(name, ".") {
+			index = matchField(fields, name[strings.LastIndex(name, ".")+1:])
+		}
+		if index != nil {
+			cf[i] = index
+		}
+	}
+	return cf
+}
+
+// structField is the field of struct which is mapped to the columns.
+type structField struct {
+	// Names of the columns which are mapped to the field.
+	columns []string
+
+	// Column of the table of the struct which is mapped to the field.
+	// It's empty if the field is mapped only to the table-qualified column.
+	column string
+
+	// Index sequence of the field for fieldByIndex.
+	index []int
+
+	tag *internal.Tag
+}
+
+// structFields returns the fields of struct which are mapped to the columns.
+// The fields of embedded struct are flattened.
+// The fields of nested struct are mapped to the prefixed columns like "dept_name" if it's tagged with prefix,
+// and the table-qualified columns like "dept.dept_name" whose qualifier is the snake case of the field name.
+// The pointer of struct is regarded as the struct, but the struct which refers to itself is not nested again.
+func structFields(typ reflect.Type, prefix, qualifier string, index []int) []structField {
+	return nestedFields(typ, prefix, qualifier, index, nil)
+}
+
+// nestedFields returns the fields of struct like structFields.
+// The parents are the types of the structs in which typ is nested.
+func nestedFields(typ reflect.Type, prefix, qualifier string, index []int, parents []reflect.Type) []structField {
+	parents = append(parents[:len(parents):len(parents)], typ)
+
+	var fields []structField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := internal.ExtractTag(f)
+		if f.PkgPath != "" || tag.Lookup("association") {
+			continue
+		}
+		idx := append(append([]int{}, index...), i)
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr && isNestedStruct(ft.Elem()) {
+			ft = ft.Elem()
+		}
+		if isNestedStruct(ft) && tag.Column == "" {
+			if containsType(parents, ft) {
 				continue
 			}
-			cf[i] = j
+			if f.Anonymous && !tag.Lookup("prefix") {
+				fields = append(fields, nestedFields(ft, prefix, qualifier, idx, parents)...)
+			} else {
+				fields = append(fields, nestedFields(ft, prefix+tag.Prefix, internal.SnakeCase(f.Name), idx, parents)...)
+			}
+			continue
+		}
+
+		c := tag.Column
+		if c == "" {
+			c = internal.SnakeCase(f.Name)
+		}
+		field := structField{index: idx, tag: tag}
+		// The fields of nested struct without prefix are mapped only to the table-qualified columns.
+		if prefix != "" || qualifier == "" {
+			field.column = prefix + c
+			field.columns = append(field.columns, field.column)
+		}
+		if qualifier != "" {
+			field.columns = append(field.columns, fmt.Sprintf("%s.%s", qualifier, prefix+c))
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// containsType reports whether types contains typ.
+func containsType(types []reflect.Type, typ reflect.Type) bool {
+	for _, t := range types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByIndex returns the nested field of v like reflect.Value.FieldByIndex.
+// If the pointer of the nested struct is nil, it's allocated when alloc is true and v is settable.
+// Otherwise, it returns the invalid value.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
 		}
+		v = v.Field(x)
 	}
-	return cf
+	return v
 }
 
+// fieldValueByIndex returns the value of the nested field of v.
+// If the pointer of the nested struct is nil, the field is regarded as zero value.
+func fieldValueByIndex(v reflect.Value, index []int) reflect.Value {
+	if fv := fieldByIndex(v, index, false); fv.IsValid() {
+		return fv
+	}
+	return reflect.Zero(v.Type().FieldByIndex(index).Type)
+}
+
+// tableFields returns the fields of struct which are mapped to the columns of its own table in order.
+// The fields of nested struct without prefix are excluded since they are mapped only to the table-qualified columns.
+// If several fields are mapped to the same column, the shallowest one is used.
+func tableFields(typ reflect.Type) []structField {
+	var fields []structField
+	seen := make(map[string]int)
+	for _, f := range structFields(typ, "", "", nil) {
+		if f.column == "" {
+			continue
+		}
+		if i, ok := seen[f.column]; ok {
+			if len(f.index) < len(fields[i].index) {
+				fields[i] = f
+			}
+			continue
+		}
+		seen[f.column] = len(fields)
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// matchField returns the index sequence of the shallowest field which is mapped to the column.
+func matchField(fields []structField, column string) []int {
+	var index []int
+	for _, f := range fields {
+		for _, c := range f.columns {
+			if c == column && (index == nil || len(f.index) < len(index)) {
+				index = f.index
+			}
+		}
+	}
+	return index
+}
+
+// isNestedStruct reports whether the type is the struct whose fields are mapped to the columns.
+// The struct which is scanned as one value like time.Time and sql.NullString is not nested struct.
+func isNestedStruct(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct || typ == reflect.TypeOf(time.Time{}) {
+		return false
+	}
+	return !reflect.PtrTo(typ).Implements(scannerType)
+}
+
+// scannerType is the type of sql.Scanner.
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
 // Types.
 var (
 	Int         = reflect.TypeOf(int(0))
@@ -1,8 +1,12 @@
 package gsorm_test
 
 import (
+	"database/sql"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/champon1020/gsorm"
 	"github.com/google/go-cmp/cmp"
@@ -78,6 +82,187 @@ func TestRowsParser_ParseStructSlice(t *testing.T) {
 	}
 }
 
+func TestRowsParser_ParseStructSlice_Embedded(t *testing.T) {
+	type Base struct {
+		ID        int `gsorm:"emp_no"`
+		CreatedAt string
+	}
+	type Employee struct {
+		Base
+		FirstName string
+		CreatedAt string `gsorm:"hire_date"`
+	}
+
+	model := []Employee{}
+	expected := []Employee{
+		{Base: Base{ID: 1001, CreatedAt: "2021-04-01"}, FirstName: "Taro", CreatedAt: "1988-04-01"},
+		{Base: Base{ID: 1002, CreatedAt: "2021-04-02"}, FirstName: "Jiro", CreatedAt: "1989-04-01"},
+	}
+
+	// Prepare the fake connection.
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("created_at", reflect.TypeOf("")),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+		newFakeColumn("hire_date", reflect.TypeOf("")),
+	}
+	v := [][]interface{}{
+		{1001, "2021-04-01", "Taro", "1988-04-01"},
+		{1002, "2021-04-02", "Jiro", "1989-04-01"},
+	}
+	rows := newFakeRows(ct, v)
+	db := newFakeDB(rows)
+
+	// Actual process.
+	if err := gsorm.Select(db).From("employees").Query(&model); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+
+	// Validate.
+	if diff := cmp.Diff(expected, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestRowsParser_ParseStructSlice_Nested(t *testing.T) {
+	type Department struct {
+		No   string
+		Name string
+	}
+	type Title struct {
+		Title string
+	}
+	type Employee struct {
+		EmpNo int
+		Dept  Department `gsorm:"prefix=dept_"`
+		Title Title
+	}
+
+	model := []Employee{}
+	expected := []Employee{
+		{EmpNo: 1001, Dept: Department{No: "d001", Name: "Marketing"}, Title: Title{Title: "Engineer"}},
+		{EmpNo: 1002, Dept: Department{No: "d002", Name: "Finance"}, Title: Title{Title: "Staff"}},
+	}
+
+	// Prepare the fake connection.
+	// The column which is not mapped to any field is discarded.
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("e.emp_no", reflect.TypeOf(0)),
+		newFakeColumn("dept_no", reflect.TypeOf("")),
+		newFakeColumn("dept.dept_name", reflect.TypeOf("")),
+		newFakeColumn("title.title", reflect.TypeOf("")),
+		newFakeColumn("from_date", reflect.TypeOf("")),
+	}
+	v := [][]interface{}{
+		{1001, "d001", "Marketing", "Engineer", "2021-04-01"},
+		{1002, "d002", "Finance", "Staff", "2021-04-01"},
+	}
+	rows := newFakeRows(ct, v)
+	db := newFakeDB(rows)
+
+	// Actual process.
+	if err := gsorm.Select(db).From("employees AS e").Query(&model); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+
+	// Validate.
+	if diff := cmp.Diff(expected, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+// upperName is converted to upper case when it's scanned.
+type upperName string
+
+func (n *upperName) Scan(src interface{}) error {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("%T cannot be scanned into upperName", src)
+	}
+	*n = upperName(strings.ToUpper(s))
+	return nil
+}
+
+func TestRowsParser_ParseStructSlice_Pointer(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName upperName
+		LastName  *string
+		HireDate  sql.NullTime
+	}
+
+	lastName := "Yamada"
+	hireDate := time.Date(1988, time.April, 1, 0, 0, 0, 0, time.UTC)
+	model := []*Employee{}
+	expected := []*Employee{
+		{ID: 1001, FirstName: "TARO", LastName: &lastName, HireDate: sql.NullTime{Time: hireDate, Valid: true}},
+		{ID: 1002, FirstName: "JIRO"},
+	}
+
+	// Prepare the fake connection.
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+		newFakeColumn("last_name", reflect.TypeOf("")),
+		newFakeColumn("hire_date", reflect.TypeOf(time.Time{})),
+	}
+	v := [][]interface{}{
+		{1001, "Taro", "Yamada", hireDate},
+		{1002, "Jiro", nil, nil},
+	}
+	rows := newFakeRows(ct, v)
+	db := newFakeDB(rows)
+
+	// Actual process.
+	if err := gsorm.Select(db).From("employees").Query(&model); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+
+	// Validate.
+	if diff := cmp.Diff(expected, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestRowsParser_ParsePtr(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}
+
+	// The pointer is allocated if the row is selected.
+	var model *Employee
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001, "Taro"}}))
+	if err := gsorm.Select(db).From("employees").Limit(1).Query(&model); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	if diff := cmp.Diff(&Employee{ID: 1001, FirstName: "Taro"}, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+
+	// The pointer is nil if no row is selected.
+	db = newFakeDB(newFakeRows(ct, [][]interface{}{}))
+	if err := gsorm.Select(db).From("employees").Limit(1).Query(&model); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	assert.Nil(t, model)
+
+	// The type implementing sql.Scanner is scanned as one value.
+	var name upperName
+	db = newFakeDB(newFakeRows([]gsorm.ExportedIColumnType{
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}, [][]interface{}{{"Taro"}}))
+	if err := gsorm.Select(db, "first_name").From("employees").Limit(1).Query(&name); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	assert.Equal(t, upperName("TARO"), name)
+}
+
 func TestRowsParser_ParseSlice(t *testing.T) {
 	model := []string{}
 	expected := []string{"Taro", "Jiro", "Saburo"}
@@ -3,7 +3,12 @@ package gsorm
 import (
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/champon1020/gsorm/explain"
+	"github.com/champon1020/gsorm/fingerprint"
 	"github.com/champon1020/gsorm/interfaces"
 	"github.com/champon1020/gsorm/interfaces/idelete"
 	"github.com/champon1020/gsorm/interfaces/iinsert"
@@ -34,6 +39,15 @@ func (s *stmt) throw(err error) {
 	s.errors = append(s.errors, err)
 }
 
+// clone returns a copy of stmt.
+// The capacities of the slices are limited so that appending to the copy doesn't affect the original.
+func (s *stmt) clone() stmt {
+	c := *s
+	c.called = s.called[:len(s.called):len(s.called)]
+	c.errors = s.errors[:len(s.errors):len(s.errors)]
+	return c
+}
+
 // Cmd returns the command clause.
 func (s *stmt) Cmd() interfaces.Clause {
 	return s.cmd
@@ -44,10 +58,35 @@ func (s *stmt) Clauses() []interfaces.Clause {
 	return s.called
 }
 
+// build builds the clause for the dialect of the connection.
+func (s *stmt) build(e interfaces.Clause) (interfaces.ClauseSet, error) {
+	return syntax.BuildClause(e, dialectOf(s.conn))
+}
+
+// fingerprint returns the fingerprint of the SQL which is built by buildSQL.
+// If any error has occurred or building SQL fails, it returns the error.
+func (s *stmt) fingerprint(buildSQL func(*internal.SQL) error) (fingerprint.Fingerprint, error) {
+	if len(s.errors) > 0 {
+		return fingerprint.Fingerprint{}, s.errors[0]
+	}
+
+	var sql internal.SQL
+	if err := buildSQL(&sql); err != nil {
+		return fingerprint.Fingerprint{}, err
+	}
+	return fingerprint.New(sql.String()), nil
+}
+
+// sql returns the SQL which is built by buildSQL.
+// If any error has occurred or building SQL fails, it returns the error message.
+// The statement is not modified, so that it can be shared between goroutines.
 func (s *stmt) sql(buildSQL func(*internal.SQL) error) string {
+	if len(s.errors) > 0 {
+		return s.errors[0].Error()
+	}
+
 	var sql internal.SQL
 	if err := buildSQL(&sql); err != nil {
-		s.throw(err)
 		return err.Error()
 	}
 	return sql.String()
@@ -85,6 +124,40 @@ func (s *stmt) CompareWith(targetStmt interfaces.Stmt) error {
 	return nil
 }
 
+// compareModels compares the models of the statements and returns error if they are not same.
+// The fields tagged with autocreatetime and autoupdatetime are ignored since they are filled by the clock.
+// If expected is nil, the models are not compared.
+func compareModels(expected, actual interface{}) error {
+	if expected == nil {
+		return nil
+	}
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	for ev.Kind() == reflect.Ptr && !ev.IsNil() {
+		ev = ev.Elem()
+	}
+	for av.Kind() == reflect.Ptr && !av.IsNil() {
+		av = av.Elem()
+	}
+	if !av.IsValid() || ev.Type() != av.Type() {
+		return xerrors.Errorf("models comparison was failed:\nexpected: %+v\nactual:   %+v\n", expected, actual)
+	}
+
+	ignored := cmp.FilterPath(func(p cmp.Path) bool {
+		sf, ok := p.Last().(cmp.StructField)
+		if !ok {
+			return false
+		}
+		f := p.Index(-2).Type().Field(sf.Index())
+		tag := internal.ExtractTag(f)
+		return f.PkgPath != "" || tag.AutoCreateTime || tag.AutoUpdateTime
+	}, cmp.Ignore())
+	if diff := cmp.Diff(ev.Interface(), av.Interface(), ignored); diff != "" {
+		return xerrors.Errorf("models comparison was failed:\nexpected: %+v\nactual:   %+v\n",
+			ev.Interface(), av.Interface())
+	}
+	return nil
+}
+
 func (s *stmt) query(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt, model interface{}) error {
 	if len(s.errors) > 0 {
 		return s.errors[0]
@@ -138,6 +211,207 @@ func (s *stmt) query(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt, m
 	return xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
 }
 
+// queryReturning executes SQL statement with RETURNING clause and maps the returned rows to model.
+// Unlike query, the fields of struct model which are not returned are kept.
+// If lastInsertIDColumn is not empty, RETURNING clause is emulated by mapping LastInsertId to the column.
+// It reports whether any row is returned. On gsorm.MockDB, it's regarded that the rows are returned.
+func (s *stmt) queryReturning(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt, model interface{},
+	lastInsertIDColumn string) (bool, error) {
+	if len(s.errors) > 0 {
+		return false, s.errors[0]
+	}
+
+	switch conn := s.conn.(type) {
+	case Mock:
+		if err := s.query(buildSQL, stmt, model); err != nil {
+			return false, err
+		}
+		return true, nil
+	case DB, Tx:
+		var sql internal.SQL
+		if err := buildSQL(&sql); err != nil {
+			return false, err
+		}
+
+		var rows irows
+		if lastInsertIDColumn != "" {
+			res, err := conn.Exec(sql.String())
+			if err != nil {
+				return false, err
+			}
+			id, err := res.LastInsertId()
+			if err != nil {
+				return false, err
+			}
+			rows = newLastInsertIDRows(lastInsertIDColumn, id)
+		} else {
+			r, err := conn.Query(sql.String())
+			if err != nil {
+				return false, err
+			}
+			defer r.Close()
+			rows = r
+		}
+
+		p, err := newRowsParser(rows, model)
+		if err != nil {
+			return false, err
+		}
+
+		v, err := p.Parse()
+		if err != nil {
+			return false, err
+		}
+
+		p.Merge(reflect.ValueOf(model).Elem(), *v)
+		return p.scanned, nil
+	}
+
+	return false, xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
+}
+
+// checkOrderByAndLimit checks whether ORDER BY or LIMIT clause can be used in UPDATE or DELETE statement.
+// They are supported only by MySQL for single-table statement.
+func checkOrderByAndLimit(e interfaces.Clause, cmd string, d Dialect, multiTable bool) error {
+	keyword := "ORDER BY"
+	if _, ok := e.(*clause.Limit); ok {
+		keyword = "LIMIT"
+	}
+	if !d.IsMySQL() {
+		return xerrors.Errorf("%s in %s is not supported by %s", keyword, cmd, d)
+	}
+	if multiTable {
+		return xerrors.Errorf("%s cannot be used with multiple-table %s", keyword, cmd)
+	}
+	return nil
+}
+
+// columnsOf converts the columns which are string or expression built by expr package to string.
+func columnsOf(columns []interface{}) ([]string, error) {
+	cols := make([]string, 0, len(columns))
+	for _, c := range columns {
+		switch c := c.(type) {
+		case string:
+			cols = append(cols, c)
+		case interfaces.Expr:
+			s, err := syntax.BuildExpr("?", c)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, s)
+		default:
+			return nil, xerrors.Errorf("%T is invalid type for column", c)
+		}
+	}
+	return cols, nil
+}
+
+// conditions normalizes WHERE, AND and OR clauses so that the conditions composed by Scopes and When are valid SQL.
+// WHERE clause which is called after another condition is built as AND clause,
+// and AND or OR clause which is called before any condition is built as WHERE clause.
+type conditions struct {
+	started bool
+}
+
+// normalize returns the clause which should be built instead of e.
+func (c *conditions) normalize(e interfaces.Clause) interfaces.Clause {
+	switch e := e.(type) {
+	case *syntax.RawClause:
+		if e.HasWhere() {
+			c.started = true
+		}
+	case *clause.Where:
+		if c.started {
+			return &clause.And{Expr: e.Expr, Values: e.Values}
+		}
+		c.started = true
+	case *clause.And:
+		if !c.started {
+			c.started = true
+			return &clause.Where{Expr: e.Expr, Values: e.Values}
+		}
+	case *clause.Or:
+		if !c.started {
+			c.started = true
+			return &clause.Where{Expr: e.Expr, Values: e.Values}
+		}
+	case *clause.SeekAfter:
+		if c.started {
+			expr, values := e.Condition()
+			return &clause.And{Expr: expr, Values: values}
+		}
+		c.started = true
+	}
+	return e
+}
+
+// scope returns the clauses in which the conditions are combined with the filter like "WHERE filter AND (conditions)".
+// If there is no condition, WHERE clause of the filter is inserted after FROM and JOIN clauses.
+// Since the conditions of RawClause cannot be enclosed, it returns error if RawClause has WHERE keyword.
+func scope(called []interfaces.Clause, filter string) ([]interfaces.Clause, error) {
+	var (
+		conds  conditions
+		where  *clause.Where
+		scoped []interfaces.Clause
+		pos    int
+	)
+	for _, e := range called {
+		if _, ok := e.(*clause.SeekAfter); ok {
+			scoped = append(scoped, e)
+			continue
+		}
+
+		var expr, keyword string
+		var values []interface{}
+		switch e := conds.normalize(e).(type) {
+		case *clause.Where:
+			expr, values = e.Expr, e.Values
+		case *clause.And:
+			expr, values, keyword = e.Expr, e.Values, "AND"
+		case *clause.Or:
+			expr, values, keyword = e.Expr, e.Values, "OR"
+		case *syntax.RawClause:
+			if e.HasWhere() {
+				return nil, xerrors.Errorf("%s cannot be combined with WHERE clause of RawClause", filter)
+			}
+			if where == nil {
+				pos = len(scoped) + 1
+			}
+			scoped = append(scoped, e)
+			continue
+		case *clause.From,
+			*clause.Join,
+			*clause.On,
+			*clause.Using,
+			*clause.AndOn,
+			*clause.OrOn,
+			*clause.UsingTables:
+			if where == nil {
+				pos = len(scoped) + 1
+			}
+			scoped = append(scoped, e)
+			continue
+		default:
+			scoped = append(scoped, e)
+			continue
+		}
+
+		if where == nil {
+			where = &clause.Where{Expr: expr, Values: values}
+			scoped = append(scoped, where)
+			continue
+		}
+		where.Expr += fmt.Sprintf(" %s (%s)", keyword, expr)
+		where.Values = append(where.Values, values...)
+	}
+
+	if where == nil {
+		return append(scoped[:pos:pos], append([]interfaces.Clause{&clause.Where{Expr: filter}}, scoped[pos:]...)...), nil
+	}
+	where.Expr = fmt.Sprintf("%s AND (%s)", filter, where.Expr)
+	return scoped, nil
+}
+
 func (s *stmt) exec(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt) error {
 	if len(s.errors) > 0 {
 		return s.errors[0]
@@ -167,39 +441,108 @@ func (s *stmt) exec(buildSQL func(*internal.SQL) error, stmt interfaces.Stmt) er
 // DeleteStmt is DELETE statement.
 type DeleteStmt struct {
 	stmt
+
+	// model is the model given by gsorm.DeleteModel, whose hooks are called.
+	model interface{}
+
+	// softDelete is the soft delete column of the model given by gsorm.DeleteModel.
+	softDelete string
+	hardDelete bool
 }
 
 // newDeleteStmt creates DeleteStmt instance.
-func newDeleteStmt(conn conn) *DeleteStmt {
+func newDeleteStmt(conn conn, tables ...string) *DeleteStmt {
+	d := &clause.Delete{}
+	for _, t := range tables {
+		d.AddTable(t)
+	}
 	s := &DeleteStmt{}
 	s.conn = conn
-	s.cmd = &clause.Delete{}
+	s.cmd = d
 	return s
 }
 
+// Clone returns a copy of DeleteStmt.
+// Clauses called on the copy don't affect the original statement.
+func (s *DeleteStmt) Clone() *DeleteStmt {
+	c := *s
+	c.stmt = s.stmt.clone()
+	return &c
+}
+
+// with returns a copy of DeleteStmt to which the clause is appended.
+func (s *DeleteStmt) with(e interfaces.Clause) *DeleteStmt {
+	c := s.Clone()
+	c.call(e)
+	return c
+}
+
 // SQL returns the built SQL string.
 func (s *DeleteStmt) SQL() string {
 	return s.sql(s.buildSQL)
 }
 
+// Fingerprint returns the normalized SQL whose values are replaced by '?' and its hash.
+// It's useful to group the queries by their shape in logs and metrics.
+// If the statement cannot be built, it returns the error.
+func (s *DeleteStmt) Fingerprint() (fingerprint.Fingerprint, error) {
+	return s.fingerprint(s.buildSQL)
+}
+
 // Exec executed SQL statement without mapping to model.
 // If type of conn is gsorm.MockDB, compare statements between called and expected.
+// The hooks of the model given by gsorm.DeleteModel are called before and after the execution.
 func (s *DeleteStmt) Exec() error {
-	return s.exec(s.buildSQL, s)
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeDeleter.BeforeDelete, AfterDeleter.AfterDelete, func() error {
+		return s.exec(s.buildSQL, s)
+	})
+}
+
+// Query executes SQL statement with RETURNING clause and maps the returned rows to model.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it maps expected values to model.
+func (s *DeleteStmt) Query(model interface{}) error {
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeDeleter.BeforeDelete, AfterDeleter.AfterDelete, func() error {
+		_, err := s.queryReturning(s.buildSQL, s, model, "")
+		return err
+	})
+}
+
+// Explain executes EXPLAIN statement of the built SQL and returns the execution plan.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it returns expected value.
+func (s *DeleteStmt) Explain(opts ...explain.Option) (*explain.Plan, error) {
+	return s.explain(s.buildSQL, s, opts)
 }
 
 // buildSQL builds SQL statement.
 func (s *DeleteStmt) buildSQL(sql *internal.SQL) error {
+	if s.softDelete != "" && !s.hardDelete {
+		return s.buildSoftDeleteSQL(sql)
+	}
+
+	d := dialectOf(s.conn)
+	deleteCmd, ok := s.cmd.(*clause.Delete)
+	if !ok {
+		return xerrors.New("command must be clause.Delete")
+	}
+	if len(deleteCmd.Tables) > 0 && !d.IsMySQL() {
+		return xerrors.Errorf("DELETE with target tables is not supported by %s", d)
+	}
+
 	ss, err := s.cmd.Build()
 	if err != nil {
 		return err
 	}
 	sql.Write(ss.Build())
 
+	multiTable := len(deleteCmd.Tables) > 0
+	var conds conditions
 	for _, e := range s.called {
-		switch e := e.(type) {
+		switch e := conds.normalize(e).(type) {
 		case *syntax.RawClause,
 			*clause.From,
+			*clause.On,
 			*clause.Where,
 			*clause.And,
 			*clause.Or:
@@ -208,6 +551,39 @@ func (s *DeleteStmt) buildSQL(sql *internal.SQL) error {
 				return err
 			}
 			sql.Write(ss.Build())
+		case *clause.Join:
+			if !d.IsMySQL() {
+				return xerrors.Errorf("JOIN in DELETE is not supported by %s", d)
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+			multiTable = true
+		case *clause.UsingTables:
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+			multiTable = true
+		case *clause.OrderBy,
+			*clause.Limit:
+			if err := checkOrderByAndLimit(e, "DELETE", d, multiTable); err != nil {
+				return err
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.Returning:
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
 		default:
 			return xerrors.Errorf("%s is invalid clause for DELETE", reflect.TypeOf(e).Elem().String())
 		}
@@ -215,10 +591,122 @@ func (s *DeleteStmt) buildSQL(sql *internal.SQL) error {
 	return nil
 }
 
+// buildSoftDeleteSQL builds UPDATE statement which sets the current time to the soft delete column
+// of the rows which are not soft deleted yet.
+func (s *DeleteStmt) buildSoftDeleteSQL(sql *internal.SQL) error {
+	d := dialectOf(s.conn)
+	var table string
+	for _, e := range s.called {
+		if f, ok := e.(*clause.From); ok && len(f.Tables) > 0 {
+			table = f.Tables[0].Build()
+			break
+		}
+	}
+	if table == "" {
+		return xerrors.New("soft delete requires FROM clause")
+	}
+	now, err := internal.FormatValue(clockOf(s.conn)(), nil)
+	if err != nil {
+		return err
+	}
+	sql.Write(fmt.Sprintf("UPDATE %s SET %s = %s", table, s.softDelete, now))
+
+	called, err := scope(s.called, fmt.Sprintf("%s IS NULL", s.softDelete))
+	if err != nil {
+		return err
+	}
+	var conds conditions
+	for _, e := range called {
+		switch e := conds.normalize(e).(type) {
+		case *clause.From:
+			continue
+		case *syntax.RawClause,
+			*clause.Where,
+			*clause.And,
+			*clause.Or:
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.OrderBy,
+			*clause.Limit:
+			if err := checkOrderByAndLimit(e, "UPDATE", d, false); err != nil {
+				return err
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.Returning:
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		default:
+			return xerrors.Errorf("%s cannot be used with soft delete", reflect.TypeOf(e).Elem().String())
+		}
+	}
+	return nil
+}
+
+// HardDelete deletes the rows actually even if the model given by gsorm.DeleteModel has the soft delete column.
+func (s *DeleteStmt) HardDelete() idelete.From {
+	c := s.Clone()
+	c.hardDelete = true
+	return c
+}
+
 // RawClause calls the raw string clause.
 func (s *DeleteStmt) RawClause(raw string, values ...interface{}) idelete.RawClause {
-	s.call(&syntax.RawClause{RawStr: raw, Values: values})
-	return s
+	return s.with(&syntax.RawClause{RawStr: raw, Values: values})
+}
+
+// Join calls INNER JOIN clause.
+// It's supported only by MySQL.
+func (s *DeleteStmt) Join(table string) idelete.Join {
+	return s.with(newJoin(clause.InnerJoin, table))
+}
+
+// LeftJoin calls LEFT JOIN clause.
+// It's supported only by MySQL.
+func (s *DeleteStmt) LeftJoin(table string) idelete.Join {
+	return s.with(newJoin(clause.LeftJoin, table))
+}
+
+// RightJoin calls RIGHT JOIN clause.
+// It's supported only by MySQL.
+func (s *DeleteStmt) RightJoin(table string) idelete.Join {
+	return s.with(newJoin(clause.RightJoin, table))
+}
+
+// On calls ON clause.
+func (s *DeleteStmt) On(expr string, values ...interface{}) idelete.On {
+	return s.with(&clause.On{Expr: expr, Values: values})
+}
+
+// Using calls USING clause.
+// It's supported only by PostgreSQL.
+func (s *DeleteStmt) Using(tables ...string) idelete.Using {
+	u := new(clause.UsingTables)
+	for _, t := range tables {
+		u.AddTable(t)
+	}
+	return s.with(u)
+}
+
+// OrderBy calls ORDER BY clause.
+// It's supported only by MySQL for single-table DELETE.
+func (s *DeleteStmt) OrderBy(columns ...string) idelete.OrderBy {
+	return s.with(&clause.OrderBy{Columns: columns})
+}
+
+// Limit calls LIMIT clause.
+// It's supported only by MySQL for single-table DELETE.
+func (s *DeleteStmt) Limit(limit int) idelete.Limit {
+	return s.with(&clause.Limit{Num: limit})
 }
 
 // From calls FROM clause.
@@ -227,33 +715,102 @@ func (s *DeleteStmt) From(tables ...string) idelete.From {
 	for _, t := range tables {
 		f.AddTable(t)
 	}
-	s.call(f)
-	return s
+	return s.with(f)
 }
 
 // Where calls WHERE clause.
 func (s *DeleteStmt) Where(expr string, values ...interface{}) idelete.Where {
-	s.call(&clause.Where{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.Where{Expr: expr, Values: values})
+}
+
+// Scopes applies the scopes to the statement in order.
+// WHERE clause which is called in the scopes after another condition is built as AND clause.
+func (s *DeleteStmt) Scopes(scopes ...func(idelete.Scope) idelete.Scope) idelete.Where {
+	sc := &deleteScope{stmt: s}
+	for _, scope := range scopes {
+		scope(sc)
+	}
+	return sc.stmt
+}
+
+// When applies fn to the statement only if cond is true.
+func (s *DeleteStmt) When(cond bool, fn func(idelete.Scope) idelete.Scope) idelete.Where {
+	if !cond {
+		return s
+	}
+	return s.Scopes(fn)
+}
+
+// deleteScope is idelete.Scope which appends the conditions to the statement.
+type deleteScope struct {
+	stmt *DeleteStmt
+}
+
+// Where calls WHERE clause.
+func (sc *deleteScope) Where(expr string, values ...interface{}) idelete.Scope {
+	sc.stmt = sc.stmt.with(&clause.Where{Expr: expr, Values: values})
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the struct model like (*DeleteStmt).WhereModel.
+func (sc *deleteScope) WhereModel(model interface{}, zeroColumns ...string) idelete.Scope {
+	sc.stmt = sc.stmt.WhereModel(model, zeroColumns...).(*DeleteStmt)
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the exported fields of the struct model.
+// Each field whose value is not zero is built as equality condition like "column = value" and they are joined with AND.
+// The zero values of the fields whose columns are included in zeroColumns are also used.
+// The slice field is built as IN condition and the nil pointer field is built as IS NULL condition.
+// The column name is determined by the field tag like the other models.
+// If it's called after another condition, it's built as AND clause.
+func (s *DeleteStmt) WhereModel(model interface{}, zeroColumns ...string) idelete.Where {
+	e, err := whereModelOf(model, zeroColumns, dialectOf(s.conn))
+	if err != nil {
+		c := s.Clone()
+		c.throw(err)
+		return c
+	}
+	if e == nil {
+		return s
+	}
+	return s.with(e)
 }
 
 // And calls AND clause.
 func (s *DeleteStmt) And(expr string, values ...interface{}) idelete.And {
-	s.call(&clause.And{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.And{Expr: expr, Values: values})
 }
 
 // Or calls OR clause.
 func (s *DeleteStmt) Or(expr string, values ...interface{}) idelete.Or {
-	s.call(&clause.Or{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.Or{Expr: expr, Values: values})
+}
+
+// Returning calls RETURNING clause.
+func (s *DeleteStmt) Returning(columns ...string) idelete.Returning {
+	return s.with(&clause.Returning{Columns: columns})
 }
 
+// defaultBatchSize is the number of rows inserted by one batched statement if the size is not given.
+// Since the values are written into SQL, the size is limited so that the statement doesn't get too long
+// (e.g. over max_allowed_packet of MySQL).
+const defaultBatchSize = 1000
+
 // InsertStmt is INSERT statement.
 type InsertStmt struct {
 	stmt
 	model interface{}
 	sel   interfaces.Stmt
+
+	// Whether the model is inserted by several statements.
+	batched bool
+
+	// Number of rows inserted by one statement.
+	batchSize int
+
+	// Whether the batched statements are executed inside one transaction.
+	inTx bool
 }
 
 // newInsertStmt creates InsertStmt instance.
@@ -267,27 +824,257 @@ func newInsertStmt(conn conn, table string, cols ...string) *InsertStmt {
 	return s
 }
 
+// Clone returns a copy of InsertStmt.
+// Clauses called on the copy don't affect the original statement.
+func (s *InsertStmt) Clone() *InsertStmt {
+	c := *s
+	c.stmt = s.stmt.clone()
+	return &c
+}
+
+// with returns a copy of InsertStmt to which the clause is appended.
+func (s *InsertStmt) with(e interfaces.Clause) *InsertStmt {
+	c := s.Clone()
+	c.call(e)
+	return c
+}
+
+// CompareWith compares the statements and their models, and returns error if they are not same.
+// The fields of the model tagged with autocreatetime and autoupdatetime are ignored.
+func (s *InsertStmt) CompareWith(targetStmt interfaces.Stmt) error {
+	if err := s.stmt.CompareWith(targetStmt); err != nil {
+		return err
+	}
+	if t, ok := targetStmt.(*InsertStmt); ok {
+		return compareModels(s.model, t.model)
+	}
+	return nil
+}
+
 // SQL returns the built SQL string.
 func (s *InsertStmt) SQL() string {
 	return s.sql(s.buildSQL)
 }
 
+// Fingerprint returns the normalized SQL whose values are replaced by '?' and its hash.
+// It's useful to group the queries by their shape in logs and metrics.
+// If the statement cannot be built, it returns the error.
+func (s *InsertStmt) Fingerprint() (fingerprint.Fingerprint, error) {
+	return s.fingerprint(s.buildSQL)
+}
+
 // Exec executed SQL statement without mapping to model.
 // If type of conn is gsorm.MockDB, compare statements between called and expected.
+// The hooks of the model are called before and after the execution.
 func (s *InsertStmt) Exec() error {
-	return s.exec(s.buildSQL, s)
-}
-
-// buildSQL builds SQL statement.
-func (s *InsertStmt) buildSQL(sql *internal.SQL) error {
-	ss, err := s.cmd.Build()
-	if err != nil {
+	if s.batched {
+		_, err := s.ExecBatch()
 		return err
 	}
-	sql.Write(ss.Build())
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeInserter.BeforeInsert, AfterInserter.AfterInsert, func() error {
+		touchModel(s.conn, s.model, false)
+		return s.exec(s.buildSQL, s)
+	})
+}
+
+// ExecBatch executes the batched statements and returns the total number of rows affected by them.
+// If InTransaction is called and conn is DB, they are executed inside one transaction,
+// and the hooks of the model are called inside it.
+// If type of conn is gsorm.MockDB, compare statements between called and expected,
+// and it's regarded that all rows of the model are inserted.
+func (s *InsertStmt) ExecBatch() (int64, error) {
+	if len(s.errors) > 0 {
+		return 0, s.errors[0]
+	}
 
-	if s.model != nil {
-		insertCmd, ok := s.cmd.(*clause.Insert)
+	conn := s.conn
+	var tx Tx
+	if db, ok := conn.(DB); ok && s.inTx {
+		var err error
+		if tx, err = db.Begin(); err != nil {
+			return 0, err
+		}
+		conn = tx
+	}
+
+	var n int64
+	err := execWithHooks(&s.stmt, conn, s.model, BeforeInserter.BeforeInsert, AfterInserter.AfterInsert, func() error {
+		touchModel(s.conn, s.model, false)
+		var err error
+		n, err = s.execBatch(conn)
+		return err
+	})
+	if tx == nil {
+		return n, err
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return 0, xerrors.Errorf("%v: %w", rbErr, err)
+		}
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// execBatch executes the batched statements in conn and returns the total number of affected rows.
+func (s *InsertStmt) execBatch(conn conn) (int64, error) {
+	switch conn := conn.(type) {
+	case Mock:
+		if _, err := conn.compareWith(s); err != nil {
+			return 0, err
+		}
+		// Mock doesn't return the result, so it's regarded that all rows are inserted.
+		models, err := s.chunks()
+		if err != nil {
+			return 0, err
+		}
+		return rowsOf(models), nil
+	case DB, Tx:
+		sqls, err := s.buildBatchSQL()
+		if err != nil {
+			return 0, err
+		}
+
+		var total int64
+		for _, sql := range sqls {
+			res, err := conn.Exec(sql)
+			if err != nil {
+				return total, err
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return total, err
+			}
+			total += n
+		}
+		return total, nil
+	}
+
+	return 0, xerrors.Errorf("database connection should not be %s", reflect.TypeOf(conn).String())
+}
+
+// Query executes SQL statement with RETURNING clause and maps the returned rows to model.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it maps expected values to model.
+func (s *InsertStmt) Query(model interface{}) error {
+	if s.batched {
+		return xerrors.New("RETURNING cannot be used with batch insert")
+	}
+	col, err := s.lastInsertIDColumn()
+	if err != nil {
+		return err
+	}
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeInserter.BeforeInsert, AfterInserter.AfterInsert, func() error {
+		touchModel(s.conn, s.model, false)
+		_, err := s.queryReturning(s.buildSQL, s, model, col)
+		return err
+	})
+}
+
+// Explain executes EXPLAIN statement of the built SQL and returns the execution plan.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it returns expected value.
+func (s *InsertStmt) Explain(opts ...explain.Option) (*explain.Plan, error) {
+	if s.batched {
+		return nil, xerrors.New("EXPLAIN cannot be used with batch insert")
+	}
+	return s.explain(s.buildSQL, s, opts)
+}
+
+// buildSQL builds SQL statement.
+// If Batch is called, the batched statements are joined with semicolons.
+func (s *InsertStmt) buildSQL(sql *internal.SQL) error {
+	if !s.batched {
+		return s.buildSQLWithModelOf(s.model, sql)
+	}
+
+	sqls, err := s.buildBatchSQL()
+	if err != nil {
+		return err
+	}
+	sql.Write(strings.Join(sqls, "; "))
+	return nil
+}
+
+// buildBatchSQL builds the batched statements.
+func (s *InsertStmt) buildBatchSQL() ([]string, error) {
+	models, err := s.chunks()
+	if err != nil {
+		return nil, err
+	}
+
+	var sqls []string
+	for _, m := range models {
+		var sql internal.SQL
+		if err := s.buildSQLWithModelOf(m, &sql); err != nil {
+			return nil, err
+		}
+		sqls = append(sqls, sql.String())
+	}
+	return sqls, nil
+}
+
+// chunks splits the slice or array model into the slices which have at most batch size elements.
+// If batch size is 0 or less, defaultBatchSize is used.
+func (s *InsertStmt) chunks() ([]interface{}, error) {
+	if s.model == nil {
+		return nil, xerrors.New("model is required for batch insert")
+	}
+	mv := reflect.ValueOf(s.model)
+	if mv.Kind() != reflect.Ptr {
+		return nil, xerrors.New("model must be a pointer")
+	}
+	mv = mv.Elem()
+	if mv.Kind() != reflect.Slice && mv.Kind() != reflect.Array {
+		return []interface{}{s.model}, nil
+	}
+
+	size := s.batchSize
+	if size <= 0 {
+		size = defaultBatchSize
+	}
+
+	var models []interface{}
+	for i := 0; i < mv.Len(); i += size {
+		j := i + size
+		if j > mv.Len() {
+			j = mv.Len()
+		}
+		chunk := mv.Slice(i, j)
+		ptr := reflect.New(chunk.Type())
+		ptr.Elem().Set(chunk)
+		models = append(models, ptr.Interface())
+	}
+	return models, nil
+}
+
+// rowsOf returns the number of rows of the models returned by chunks.
+func rowsOf(models []interface{}) int64 {
+	var n int64
+	for _, m := range models {
+		mv := reflect.ValueOf(m).Elem()
+		if mv.Kind() == reflect.Slice || mv.Kind() == reflect.Array {
+			n += int64(mv.Len())
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// buildSQLWithModelOf builds SQL statement which inserts the model.
+func (s *InsertStmt) buildSQLWithModelOf(model interface{}, sql *internal.SQL) error {
+	ss, err := s.cmd.Build()
+	if err != nil {
+		return err
+	}
+	sql.Write(ss.Build())
+
+	if model != nil {
+		insertCmd, ok := s.cmd.(*clause.Insert)
 		if !ok {
 			return xerrors.New("command must be clause.Insert")
 		}
@@ -299,21 +1086,19 @@ func (s *InsertStmt) buildSQL(sql *internal.SQL) error {
 			}
 			cols = append(cols, c.Name)
 		}
-		if err := s.buildSQLWithModel(cols, s.model, sql); err != nil {
+		if err := s.buildSQLWithModel(cols, model, sql); err != nil {
 			return err
 		}
-		return nil
-	}
-
-	if s.sel != nil {
+	} else if s.sel != nil {
 		sql.Write(s.sel.SQL())
-		return nil
+	} else if err := s.buildSQLWithClauses(sql); err != nil {
+		return err
 	}
 
-	if err := s.buildSQLWithClauses(sql); err != nil {
+	if err := s.buildSQLWithUpsert(sql); err != nil {
 		return err
 	}
-	return nil
+	return s.buildSQLWithReturning(sql)
 }
 
 // buildSQLWithClauses builds SQL statement from called clauses.
@@ -339,6 +1124,13 @@ func (s *InsertStmt) buildSQLWithClauses(sql *internal.SQL) error {
 				return err
 			}
 			sql.Write(ss.Build())
+		case *clause.OnConflict,
+			*clause.DoNothing,
+			*clause.DoUpdate,
+			*clause.UpdateFromExcluded,
+			*clause.Returning:
+			// These clauses are built by buildSQLWithUpsert and buildSQLWithReturning.
+			continue
 		default:
 			return xerrors.Errorf("%s is invalid clause for INSERT", reflect.TypeOf(e).Elem().String())
 		}
@@ -346,6 +1138,122 @@ func (s *InsertStmt) buildSQLWithClauses(sql *internal.SQL) error {
 	return nil
 }
 
+// buildSQLWithUpsert builds ON CONFLICT clause and its action from called clauses.
+// On MySQL, ON DUPLICATE KEY UPDATE clause is built instead.
+func (s *InsertStmt) buildSQLWithUpsert(sql *internal.SQL) error {
+	d := dialectOf(s.conn)
+	var conflict *clause.OnConflict
+	updateCalled := false
+	for _, e := range s.called {
+		switch e := e.(type) {
+		case *clause.OnConflict:
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+			conflict = e
+		case *clause.DoNothing:
+			if !d.IsMySQL() {
+				ss, err := s.build(e)
+				if err != nil {
+					return err
+				}
+				sql.Write(ss.Build())
+				continue
+			}
+			// MySQL doesn't have DO NOTHING, so the column is updated with its own value.
+			col, err := s.doNothingColumn(conflict)
+			if err != nil {
+				return err
+			}
+			sql.Write(fmt.Sprintf("%s = %s", col, col))
+		case *clause.DoUpdate,
+			*clause.UpdateFromExcluded:
+			if !d.IsMySQL() && (conflict == nil || len(conflict.Columns) == 0) {
+				return xerrors.New("conflict target columns are required for DO UPDATE")
+			}
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			if updateCalled {
+				sql.Write(",")
+				sql.Write(ss.BuildValue())
+				continue
+			}
+			sql.Write(ss.Build())
+			updateCalled = true
+		}
+	}
+	return nil
+}
+
+// buildSQLWithReturning builds RETURNING clause from called clauses.
+// On MySQL, RETURNING clause is not built because it is emulated with LastInsertId.
+func (s *InsertStmt) buildSQLWithReturning(sql *internal.SQL) error {
+	if dialectOf(s.conn).IsMySQL() {
+		return nil
+	}
+	for _, e := range s.called {
+		if e, ok := e.(*clause.Returning); ok {
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		}
+	}
+	return nil
+}
+
+// lastInsertIDColumn returns the column to which LastInsertId is mapped when RETURNING clause is emulated on MySQL.
+// It returns empty string if RETURNING clause is not emulated.
+func (s *InsertStmt) lastInsertIDColumn() (string, error) {
+	if !dialectOf(s.conn).IsMySQL() {
+		return "", nil
+	}
+
+	var returning *clause.Returning
+	numOfValues := 0
+	for _, e := range s.called {
+		switch e := e.(type) {
+		case *clause.Returning:
+			returning = e
+		case *clause.Values:
+			numOfValues++
+		}
+	}
+	if returning == nil {
+		return "", nil
+	}
+	if len(returning.Columns) != 1 {
+		return "", xerrors.New("RETURNING on MySQL must have only one column which is mapped to LastInsertId")
+	}
+
+	singleRow := numOfValues == 1
+	if s.model != nil {
+		k := reflect.Indirect(reflect.ValueOf(s.model)).Kind()
+		singleRow = k == reflect.Struct || k == reflect.Map
+	}
+	if s.sel != nil || !singleRow {
+		return "", xerrors.New("RETURNING on MySQL is only supported for single-row INSERT")
+	}
+	return returning.Columns[0], nil
+}
+
+// doNothingColumn returns the column which is used to emulate DO NOTHING on MySQL.
+func (s *InsertStmt) doNothingColumn(conflict *clause.OnConflict) (string, error) {
+	if conflict != nil && len(conflict.Columns) > 0 {
+		return conflict.Columns[0], nil
+	}
+	insertCmd, ok := s.cmd.(*clause.Insert)
+	if !ok || len(insertCmd.Columns) == 0 {
+		return "", xerrors.New("column is required for DO NOTHING on MySQL")
+	}
+	return insertCmd.Columns[0].Name, nil
+}
+
 // buildSQLWithModel builds SQL statement from model.
 func (s *InsertStmt) buildSQLWithModel(cols []string, model interface{}, sql *internal.SQL) error {
 	sql.Write("VALUES")
@@ -365,20 +1273,67 @@ func (s *InsertStmt) buildSQLWithModel(cols []string, model interface{}, sql *in
 
 // RawClause calls the raw string clause.
 func (s *InsertStmt) RawClause(raw string, values ...interface{}) iinsert.RawClause {
-	s.call(&syntax.RawClause{RawStr: raw, Values: values})
-	return s
+	return s.with(&syntax.RawClause{RawStr: raw, Values: values})
 }
 
 // Model sets model to InsertStmt.
 func (s *InsertStmt) Model(model interface{}) iinsert.Model {
-	s.model = model
-	return s
+	c := s.Clone()
+	c.model = model
+	return c
+}
+
+// Batch splits the insert of slice or array model into several statements
+// each of which inserts at most size rows.
+// If size is 0 or less, 1000 rows are inserted by one statement.
+func (s *InsertStmt) Batch(size int) iinsert.Batch {
+	c := s.Clone()
+	c.batched = true
+	c.batchSize = size
+	return c
+}
+
+// InTransaction executes the batched statements inside one transaction.
+// If conn is already Tx, they are executed inside it.
+func (s *InsertStmt) InTransaction() iinsert.Batch {
+	c := s.Clone()
+	c.inTx = true
+	return c
 }
 
 // Select calls SELECT statement.
 func (s *InsertStmt) Select(stmt interfaces.Stmt) iinsert.Select {
-	s.sel = stmt
-	return s
+	c := s.Clone()
+	c.sel = stmt
+	return c
+}
+
+// OnConflict calls ON CONFLICT clause.
+// On MySQL, ON DUPLICATE KEY UPDATE clause is called and the columns are ignored.
+func (s *InsertStmt) OnConflict(columns ...string) iinsert.OnConflict {
+	return s.with(&clause.OnConflict{Columns: columns})
+}
+
+// DoNothing calls DO NOTHING action of ON CONFLICT clause.
+func (s *InsertStmt) DoNothing() iinsert.DoNothing {
+	return s.with(&clause.DoNothing{})
+}
+
+// DoUpdate calls DO UPDATE SET action of ON CONFLICT clause.
+func (s *InsertStmt) DoUpdate(column string, value interface{}) iinsert.DoUpdate {
+	return s.with(&clause.DoUpdate{Column: column, Value: value})
+}
+
+// UpdateFromExcluded calls DO UPDATE SET action of ON CONFLICT clause
+// which updates the columns with the values proposed for insertion.
+func (s *InsertStmt) UpdateFromExcluded(columns ...string) iinsert.DoUpdate {
+	return s.with(&clause.UpdateFromExcluded{Columns: columns})
+}
+
+// Returning calls RETURNING clause.
+// On MySQL, it is emulated with LastInsertId, so only one column can be returned for single-row INSERT.
+func (s *InsertStmt) Returning(columns ...string) iinsert.Returning {
+	return s.with(&clause.Returning{Columns: columns})
 }
 
 // Values calls VALUES clause.
@@ -387,13 +1342,19 @@ func (s *InsertStmt) Values(values ...interface{}) iinsert.Values {
 	for _, val := range values {
 		v.AddValue(val)
 	}
-	s.call(v)
-	return s
+	return s.with(v)
 }
 
 // SelectStmt is SELECT statement.
 type SelectStmt struct {
 	stmt
+
+	// softDelete is the soft delete column of the model given by Columns.
+	softDelete string
+	unscoped   bool
+
+	// preloads are the names of associations which are loaded after the query.
+	preloads []string
 }
 
 // newSelectStmt creates SelectStmt instance.
@@ -410,32 +1371,125 @@ func newSelectStmt(conn conn, cols ...string) *SelectStmt {
 	return s
 }
 
+// Clone returns a copy of SelectStmt.
+// Clauses called on the copy don't affect the original statement.
+func (s *SelectStmt) Clone() *SelectStmt {
+	c := *s
+	c.stmt = s.stmt.clone()
+	return &c
+}
+
+// with returns a copy of SelectStmt to which the clause is appended.
+func (s *SelectStmt) with(e interfaces.Clause) *SelectStmt {
+	c := s.Clone()
+	c.call(e)
+	return c
+}
+
 // SQL returns the built SQL string.
 func (s *SelectStmt) SQL() string {
 	return s.sql(s.buildSQL)
 }
 
+// Fingerprint returns the normalized SQL whose values are replaced by '?' and its hash.
+// It's useful to group the queries by their shape in logs and metrics.
+// If the statement cannot be built, it returns the error.
+func (s *SelectStmt) Fingerprint() (fingerprint.Fingerprint, error) {
+	return s.fingerprint(s.buildSQL)
+}
+
 // Query executes SQL statement with mapping to model.
 // If type of (*SelectStmt).conn is gsorm.MockDB, compare statements between called and expected.
 // Then, it maps expected values to model.
+// The associations given by Preload are loaded, and then AfterFind of the model is called after the mapping.
 func (s *SelectStmt) Query(model interface{}) error {
-	return s.query(s.buildSQL, s, model)
+	if err := s.query(s.buildSQL, s, model); err != nil {
+		return err
+	}
+	return s.afterQuery(model)
+}
+
+// afterQuery loads the associations of the model and calls AfterFind of it.
+func (s *SelectStmt) afterQuery(model interface{}) error {
+	if err := preload(s.conn, model, s.preloads, s.unscoped); err != nil {
+		return err
+	}
+	return runHooks(s.conn, model, AfterFinder.AfterFind)
+}
+
+// Explain executes EXPLAIN statement of the built SQL and returns the execution plan.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it returns expected value.
+func (s *SelectStmt) Explain(opts ...explain.Option) (*explain.Plan, error) {
+	return s.explain(s.buildSQL, s, opts)
 }
 
 // buildSQL builds SQL statement from called clauses.
 func (s *SelectStmt) buildSQL(sql *internal.SQL) error {
-	ss, err := s.cmd.Build()
+	// If the set operation like UNION is called, the first statement is also enclosed by parentheses
+	// so that ORDER BY, LIMIT and OFFSET which are called after it are applied to the combined result.
+	parens := false
+	if dialectOf(s.conn) != internal.SQLite {
+		for _, e := range s.called {
+			if clause.IsSetOperation(e) {
+				parens = true
+				break
+			}
+		}
+	}
+	if parens {
+		sql.Write("(")
+	}
+
+	ss, err := s.selectClause().Build()
 	if err != nil {
 		return err
 	}
 	sql.Write(ss.Build())
 
-	for _, e := range s.called {
-		switch e := e.(type) {
+	called := s.called
+	if s.softDelete != "" && !s.unscoped {
+		var err error
+		if called, err = scope(called, fmt.Sprintf("%s IS NULL", s.qualify(s.softDelete))); err != nil {
+			return err
+		}
+	}
+
+	// The keyset condition of SeekAfter is written before GROUP BY, HAVING or ORDER BY clause.
+	var seek interfaces.Clause
+	for _, e := range called {
+		if e, ok := e.(*clause.SeekAfter); ok && len(e.Columns) > 0 {
+			seek = e
+		}
+	}
+
+	var conds conditions
+	for _, e := range called {
+		switch e.(type) {
+		case *clause.SeekAfter:
+			continue
+		case *clause.GroupBy, *clause.Having, *clause.OrderBy:
+			if seek != nil {
+				ss, err := s.build(conds.normalize(seek))
+				if err != nil {
+					return err
+				}
+				sql.Write(ss.Build())
+				seek = nil
+			}
+		}
+		if seek != nil && clause.IsSetOperation(e) {
+			return xerrors.New("SeekAfter cannot be used with set operations")
+		}
+
+		switch e := conds.normalize(e).(type) {
 		case *syntax.RawClause,
 			*clause.From,
 			*clause.Join,
 			*clause.On,
+			*clause.Using,
+			*clause.AndOn,
+			*clause.OrOn,
 			*clause.Where,
 			*clause.And,
 			*clause.Or,
@@ -444,112 +1498,777 @@ func (s *SelectStmt) buildSQL(sql *internal.SQL) error {
 			*clause.OrderBy,
 			*clause.Limit,
 			*clause.Offset,
-			*clause.Union:
-			s, err := e.Build()
+			*clause.Lock,
+			*clause.Of,
+			*clause.NoWait,
+			*clause.SkipLocked:
+			ss, err := s.build(e)
 			if err != nil {
 				return err
 			}
-			sql.Write(s.Build())
+			sql.Write(ss.Build())
+		case *clause.Union,
+			*clause.Intersect,
+			*clause.Except:
+			if parens {
+				sql.Write(")")
+				parens = false
+			}
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
 		default:
 			return xerrors.Errorf("%s is invalid clause for SELECT", reflect.TypeOf(e).Elem().String())
 		}
 	}
 
-	return nil
+	return nil
+}
+
+// selectClause returns SELECT clause to be built.
+// The model columns are qualified if the joins are called or the multiple tables are selected.
+func (s *SelectStmt) selectClause() interfaces.Clause {
+	sel, ok := s.cmd.(*clause.Select)
+	if !ok || len(sel.ModelColumns) == 0 {
+		return s.cmd
+	}
+	if table := s.qualifier(); table != "" {
+		return sel.Qualify(table)
+	}
+	return sel
+}
+
+// qualifier returns the alias or the name of the first table of FROM clause
+// if the joins are called or the multiple tables are selected. Otherwise, it returns empty string.
+func (s *SelectStmt) qualifier() string {
+	var from *clause.From
+	joined := false
+	for _, e := range s.called {
+		switch e := e.(type) {
+		case *clause.From:
+			if from == nil {
+				from = e
+			}
+		case *clause.Join:
+			joined = true
+		}
+	}
+	if from == nil || len(from.Tables) == 0 || (!joined && len(from.Tables) == 1) {
+		return ""
+	}
+
+	t := from.Tables[0]
+	if t.Alias != "" {
+		return t.Alias
+	}
+	return t.Name
+}
+
+// qualify returns the column qualified by the qualifier.
+func (s *SelectStmt) qualify(column string) string {
+	if table := s.qualifier(); table != "" && !strings.Contains(column, ".") {
+		return fmt.Sprintf("%s.%s", table, column)
+	}
+	return column
+}
+
+// Columns calls SELECT clause with the mapped columns of the model.
+// The model is the struct, the slice of struct or the pointer of them.
+// If the joins are called, the columns are qualified with the alias or the name of the table of FROM clause.
+func (s *SelectStmt) Columns(model interface{}) iselect.Stmt {
+	cols, err := modelColumns(modelType(model))
+	if err != nil {
+		c := s.Clone()
+		c.throw(err)
+		return c
+	}
+
+	c := s.Clone()
+	sel := &clause.Select{}
+	if cur, ok := s.cmd.(*clause.Select); ok {
+		// The default column "*" is replaced by the model columns.
+		if len(cur.Columns) != 1 || cur.Columns[0].Name != "*" {
+			sel.Columns = append(sel.Columns, cur.Columns...)
+		}
+		sel.ModelColumns = append(sel.ModelColumns, cur.ModelColumns...)
+	}
+	sel.ModelColumns = append(sel.ModelColumns, cols...)
+	c.cmd = sel
+	if col := softDeleteColumn(modelType(model)); col != "" {
+		c.softDelete = col
+	}
+	return c
+}
+
+// Unscoped disables the filter of soft deleted rows which is added by the model given by Columns.
+func (s *SelectStmt) Unscoped() iselect.Stmt {
+	c := s.Clone()
+	c.unscoped = true
+	return c
+}
+
+// Preload loads the associations of the model after the query by one batched query for each association.
+// The association is declared by the field tag like `gsorm:"hasmany=Salaries,fk=emp_no"`.
+func (s *SelectStmt) Preload(associations ...string) iselect.Stmt {
+	c := s.Clone()
+	c.preloads = append(append([]string{}, s.preloads...), associations...)
+	return c
+}
+
+// RawClause calls the raw string clause.
+func (s *SelectStmt) RawClause(raw string, values ...interface{}) iselect.RawClause {
+	return s.with(&syntax.RawClause{RawStr: raw, Values: values})
+}
+
+// From calls FROM clause.
+func (s *SelectStmt) From(tables ...string) iselect.From {
+	f := new(clause.From)
+	for _, t := range tables {
+		f.AddTable(t)
+	}
+	return s.with(f)
+}
+
+// FromModel calls FROM clause with the table name of the model.
+// If the table name cannot be derived from the model, the error is returned by the execution.
+func (s *SelectStmt) FromModel(model interface{}) iselect.From {
+	table, err := From(s.conn, model)
+	if err != nil {
+		c := s.Clone()
+		c.throw(err)
+		return c
+	}
+	return s.From(table)
+}
+
+// Where calls WHERE clause.
+func (s *SelectStmt) Where(expr string, values ...interface{}) iselect.Where {
+	return s.with(&clause.Where{Expr: expr, Values: values})
+}
+
+// Scopes applies the scopes to the statement in order.
+// WHERE clause which is called in the scopes after another condition is built as AND clause.
+func (s *SelectStmt) Scopes(scopes ...func(iselect.Scope) iselect.Scope) iselect.Where {
+	sc := &selectScope{stmt: s}
+	for _, scope := range scopes {
+		scope(sc)
+	}
+	return sc.stmt
+}
+
+// When applies fn to the statement only if cond is true.
+func (s *SelectStmt) When(cond bool, fn func(iselect.Scope) iselect.Scope) iselect.Where {
+	if !cond {
+		return s
+	}
+	return s.Scopes(fn)
+}
+
+// selectScope is iselect.Scope which appends the conditions to the statement.
+type selectScope struct {
+	stmt *SelectStmt
+}
+
+// Where calls WHERE clause.
+func (sc *selectScope) Where(expr string, values ...interface{}) iselect.Scope {
+	sc.stmt = sc.stmt.with(&clause.Where{Expr: expr, Values: values})
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the struct model like (*SelectStmt).WhereModel.
+func (sc *selectScope) WhereModel(model interface{}, zeroColumns ...string) iselect.Scope {
+	sc.stmt = sc.stmt.WhereModel(model, zeroColumns...).(*SelectStmt)
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the exported fields of the struct model.
+// Each field whose value is not zero is built as equality condition like "column = value" and they are joined with AND.
+// The zero values of the fields whose columns are included in zeroColumns are also used.
+// The slice field is built as IN condition and the nil pointer field is built as IS NULL condition.
+// The column name is determined by the field tag like the other models.
+// If it's called after another condition, it's built as AND clause.
+func (s *SelectStmt) WhereModel(model interface{}, zeroColumns ...string) iselect.Where {
+	e, err := whereModelOf(model, zeroColumns, dialectOf(s.conn))
+	if err != nil {
+		c := s.Clone()
+		c.throw(err)
+		return c
+	}
+	if e == nil {
+		return s
+	}
+	return s.with(e)
+}
+
+// And calls AND clause.
+func (s *SelectStmt) And(expr string, values ...interface{}) iselect.And {
+	return s.with(&clause.And{Expr: expr, Values: values})
+}
+
+// Or calls OR clause.
+func (s *SelectStmt) Or(expr string, values ...interface{}) iselect.Or {
+	return s.with(&clause.Or{Expr: expr, Values: values})
+}
+
+// Limit calls LIMIT clause.
+func (s *SelectStmt) Limit(limit int) iselect.Limit {
+	return s.with(&clause.Limit{Num: limit})
+}
+
+// Offset calls OFFSET clause.
+func (s *SelectStmt) Offset(offset int) iselect.Offset {
+	return s.with(&clause.Offset{Num: offset})
+}
+
+// SeekAfter calls the keyset condition which selects the rows after the cursor.
+// The cursor is the token encoded by EncodeCursor or NextCursor with the values of the columns of ORDER BY clause.
+// If the cursor is empty, the rows are selected from the beginning.
+func (s *SelectStmt) SeekAfter(cursor string) iselect.SeekAfter {
+	e := &clause.SeekAfter{Cursor: cursor}
+	if cursor == "" {
+		return s.with(e)
+	}
+
+	columns := []string{}
+	desc := []bool{}
+	for _, c := range s.called {
+		o, ok := c.(*clause.OrderBy)
+		if !ok {
+			continue
+		}
+		for _, col := range o.Columns {
+			strs := strings.Fields(col)
+			if len(strs) == 0 {
+				continue
+			}
+			isDesc := false
+			for _, str := range strs[1:] {
+				if strings.ToUpper(str) == "DESC" {
+					isDesc = true
+				}
+			}
+			columns = append(columns, strs[0])
+			desc = append(desc, isDesc)
+		}
+	}
+
+	values, err := decodeCursor(cursor)
+	if err != nil {
+		c := s.with(e)
+		c.throw(err)
+		return c
+	}
+	if len(values) != len(columns) {
+		c := s.with(e)
+		c.throw(xerrors.Errorf("cursor has %d values but ORDER BY has %d columns", len(values), len(columns)))
+		return c
+	}
+
+	d := dialectOf(s.conn)
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			values[i] = escapeString(v, d)
+		case time.Time:
+			// The time is written with fractional seconds so that the rows which have the same second are not skipped.
+			values[i] = v.Format(cursorTimeLayout)
+		}
+	}
+	e.Columns = columns
+	e.Desc = desc
+	e.Values = values
+	return s.with(e)
+}
+
+// Paginate executes SQL statement with LIMIT and OFFSET clauses for the page and maps rows to model.
+// It also executes the derived count query which drops ORDER BY, LIMIT and OFFSET clauses,
+// and returns the total number of rows. page starts from 1.
+// If type of conn is gsorm.MockDB, the statement of the page and the count query are compared in order.
+func (s *SelectStmt) Paginate(model interface{}, page, size int) (int64, error) {
+	if page < 1 || size < 1 {
+		return 0, xerrors.New("page and size must be greater than 0")
+	}
+
+	items := s.with(&clause.Limit{Num: size})
+	items.call(&clause.Offset{Num: (page - 1) * size})
+	if err := items.Query(model); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	if err := s.countStmt().Query(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// countStmt returns the statement which counts the rows selected by the statement.
+// If the statement has GROUP BY, HAVING, DISTINCT or set operations, it is wrapped as the derived table.
+func (s *SelectStmt) countStmt() *SelectStmt {
+	derived := false
+	if sel, ok := s.cmd.(*clause.Select); ok {
+		for _, c := range sel.Columns {
+			if strings.HasPrefix(strings.ToUpper(c.Name), "DISTINCT") {
+				derived = true
+			}
+		}
+	}
+
+	called := []interfaces.Clause{}
+	for _, e := range s.called {
+		switch e.(type) {
+		case *clause.OrderBy,
+			*clause.Limit,
+			*clause.Offset,
+			*clause.Lock,
+			*clause.Of,
+			*clause.NoWait,
+			*clause.SkipLocked:
+			continue
+		case *clause.GroupBy,
+			*clause.Having:
+			derived = true
+		}
+		if clause.IsSetOperation(e) {
+			derived = true
+		}
+		called = append(called, e)
+	}
+
+	c := newSelectStmt(s.conn, "COUNT(*)")
+	c.errors = append(c.errors, s.errors...)
+	if derived {
+		sub := &SelectStmt{stmt: stmt{conn: s.conn, cmd: s.cmd, called: called}, softDelete: s.softDelete, unscoped: s.unscoped}
+		var sql internal.SQL
+		if err := sub.buildSQL(&sql); err != nil {
+			c.throw(err)
+		}
+		c.call(&syntax.RawClause{RawStr: fmt.Sprintf("FROM (%s) AS paginated", sql.String())})
+		return c
+	}
+	c.called = called
+	c.softDelete = s.softDelete
+	c.unscoped = s.unscoped
+	return c
+}
+
+// First executes SQL statement with LIMIT 1 and maps the first row to model.
+// If no row is selected, it returns ErrNoRows.
+// If type of conn is gsorm.MockDB, compare the statement with LIMIT 1 with expected,
+// and maps the expected value or the first element of it if it is a slice.
+func (s *SelectStmt) First(model interface{}) error {
+	if len(s.errors) > 0 {
+		return s.errors[0]
+	}
+	mv := reflect.ValueOf(model)
+	if mv.Kind() != reflect.Ptr {
+		return xerrors.New("model must be a pointer")
+	}
+
+	c := s.with(&clause.Limit{Num: 1})
+	if conn, ok := s.conn.(Mock); ok {
+		returned, err := conn.compareWith(c)
+		if err != nil {
+			return err
+		}
+		v := reflect.ValueOf(returned)
+		if v.Kind() == reflect.Slice && v.Type() != mv.Elem().Type() {
+			if v.Len() == 0 {
+				return ErrNoRows
+			}
+			v = v.Index(0)
+		}
+		if !v.IsValid() {
+			return ErrNoRows
+		}
+		if !v.Type().AssignableTo(mv.Elem().Type()) {
+			return xerrors.Errorf("returned value of %s cannot be mapped to %s",
+				v.Type().String(), mv.Elem().Type().String())
+		}
+		mv.Elem().Set(v)
+		return s.afterQuery(model)
+	}
+
+	sl := reflect.New(reflect.SliceOf(mv.Elem().Type()))
+	if err := c.query(c.buildSQL, c, sl.Interface()); err != nil {
+		return err
+	}
+	if sl.Elem().Len() == 0 {
+		return ErrNoRows
+	}
+	mv.Elem().Set(sl.Elem().Index(0))
+	return s.afterQuery(model)
+}
+
+// Exists executes SQL statement like "SELECT EXISTS (...)" and returns whether any row is selected.
+// If type of conn is gsorm.MockDB, compare the statement with expected and returns the expected value.
+func (s *SelectStmt) Exists() (bool, error) {
+	if len(s.errors) > 0 {
+		return false, s.errors[0]
+	}
+	if _, ok := s.conn.(Mock); ok {
+		v, err := s.queryValue()
+		if err != nil {
+			return false, err
+		}
+		return toBool(v)
+	}
+
+	var sql internal.SQL
+	if err := s.buildSQL(&sql); err != nil {
+		return false, err
+	}
+	v, err := newSelectStmt(s.conn, fmt.Sprintf("EXISTS (%s)", sql.String())).queryValue()
+	if err != nil {
+		return false, err
+	}
+	return toBool(v)
+}
+
+// Pluck executes SQL statement which selects only the column and maps the values to model.
+// model must be a pointer of slice.
+// If type of conn is gsorm.MockDB, compare the statement which selects only the column with expected
+// and maps the expected value.
+func (s *SelectStmt) Pluck(column string, model interface{}) error {
+	c := s.Clone()
+	sel := new(clause.Select)
+	sel.AddColumns(column)
+	c.cmd = sel
+	return c.query(c.buildSQL, c, model)
+}
+
+// Int64 executes SQL statement and returns the value of the first column of the first row as int64.
+// It's useful for the aggregate queries built by gsorm.Count or gsorm.Sum. NULL is returned as 0.
+// If type of conn is gsorm.MockDB, compare the statement with expected and returns the expected value.
+func (s *SelectStmt) Int64() (int64, error) {
+	v, err := s.queryValue()
+	if err != nil {
+		return 0, err
+	}
+	return toInt64(v)
+}
+
+// Float64 executes SQL statement and returns the value of the first column of the first row as float64.
+// NULL is returned as 0.
+// If type of conn is gsorm.MockDB, compare the statement with expected and returns the expected value.
+func (s *SelectStmt) Float64() (float64, error) {
+	v, err := s.queryValue()
+	if err != nil {
+		return 0, err
+	}
+	return toFloat64(v)
+}
+
+// StringValue executes SQL statement and returns the value of the first column of the first row as string.
+// NULL is returned as empty string. It isn't named String since String returns the statement as string.
+// If type of conn is gsorm.MockDB, compare the statement with expected and returns the expected value.
+func (s *SelectStmt) StringValue() (string, error) {
+	v, err := s.queryValue()
+	if err != nil {
+		return "", err
+	}
+	return toStringValue(v)
+}
+
+// queryValue executes SQL statement and returns the value of the first column of the first row.
+// If no row is selected, it returns ErrNoRows.
+// If type of conn is gsorm.MockDB, compare statements between called and expected, and returns the expected value.
+func (s *SelectStmt) queryValue() (interface{}, error) {
+	if len(s.errors) > 0 {
+		return nil, s.errors[0]
+	}
+
+	switch conn := s.conn.(type) {
+	case Mock:
+		return conn.compareWith(s)
+	case DB, Tx:
+		var sql internal.SQL
+		if err := s.buildSQL(&sql); err != nil {
+			return nil, err
+		}
+
+		rows, err := conn.Query(sql.String())
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		if !rows.Next() {
+			return nil, ErrNoRows
+		}
+		ct, err := rows.ColumnTypes()
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, len(ct))
+		ptrs := make([]interface{}, len(ct))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			return nil, xerrors.New("no column is selected")
+		}
+		return values[0], nil
+	}
+
+	return nil, xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
+}
+
+// toInt64 converts the scanned value to int64.
+func toInt64(v interface{}) (int64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case []byte:
+		return strconv.ParseInt(string(v), 10, 64)
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), nil
+	}
+	return 0, xerrors.Errorf("%s cannot be converted to int64", rv.Type().String())
+}
+
+// toFloat64 converts the scanned value to float64.
+func toFloat64(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+	return 0, xerrors.Errorf("%s cannot be converted to float64", rv.Type().String())
+}
+
+// toStringValue converts the scanned value to string.
+func toStringValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05"), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	}
+	return "", xerrors.Errorf("%s cannot be converted to string", rv.Type().String())
+}
+
+// toBool converts the scanned value to bool.
+func toBool(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case []byte:
+		return strconv.ParseBool(string(v))
+	case string:
+		return strconv.ParseBool(v)
+	}
+	i, err := toInt64(v)
+	if err != nil {
+		return false, xerrors.Errorf("%s cannot be converted to bool", reflect.TypeOf(v).String())
+	}
+	return i != 0, nil
 }
 
-// RawClause calls the raw string clause.
-func (s *SelectStmt) RawClause(raw string, values ...interface{}) iselect.RawClause {
-	s.call(&syntax.RawClause{RawStr: raw, Values: values})
-	return s
+// OrderBy calls ORDER BY clause.
+func (s *SelectStmt) OrderBy(columns ...string) iselect.OrderBy {
+	return s.with(&clause.OrderBy{Columns: columns})
 }
 
-// From calls FROM clause.
-func (s *SelectStmt) From(tables ...string) iselect.From {
-	f := new(clause.From)
-	for _, t := range tables {
-		f.AddTable(t)
+// OrderByExpr calls ORDER BY clause whose column can be the expression built by expr package.
+func (s *SelectStmt) OrderByExpr(columns ...interface{}) iselect.OrderBy {
+	cols, err := columnsOf(columns)
+	c := s.with(&clause.OrderBy{Columns: cols})
+	if err != nil {
+		c.throw(err)
 	}
-	s.call(f)
-	return s
+	return c
 }
 
-// Where calls WHERE clause.
-func (s *SelectStmt) Where(expr string, values ...interface{}) iselect.Where {
-	s.call(&clause.Where{Expr: expr, Values: values})
-	return s
+// Join calls (INNER) JOIN clause.
+func (s *SelectStmt) Join(table string) iselect.Join {
+	return s.with(newJoin(clause.InnerJoin, table))
 }
 
-// And calls AND clause.
-func (s *SelectStmt) And(expr string, values ...interface{}) iselect.And {
-	s.call(&clause.And{Expr: expr, Values: values})
-	return s
+// LeftJoin calls LEFT JOIN clause.
+func (s *SelectStmt) LeftJoin(table string) iselect.Join {
+	return s.with(newJoin(clause.LeftJoin, table))
 }
 
-// Or calls OR clause.
-func (s *SelectStmt) Or(expr string, values ...interface{}) iselect.Or {
-	s.call(&clause.Or{Expr: expr, Values: values})
-	return s
+// RightJoin calls RIGHT JOIN clause.
+func (s *SelectStmt) RightJoin(table string) iselect.Join {
+	return s.with(newJoin(clause.RightJoin, table))
 }
 
-// Limit calls LIMIT clause.
-func (s *SelectStmt) Limit(limit int) iselect.Limit {
-	s.call(&clause.Limit{Num: limit})
-	return s
+// FullJoin calls FULL OUTER JOIN clause.
+func (s *SelectStmt) FullJoin(table string) iselect.Join {
+	return s.with(newJoin(clause.FullJoin, table))
 }
 
-// Offset calls OFFSET clause.
-func (s *SelectStmt) Offset(offset int) iselect.Offset {
-	s.call(&clause.Offset{Num: offset})
-	return s
+// CrossJoin calls CROSS JOIN clause.
+func (s *SelectStmt) CrossJoin(table string) iselect.From {
+	return s.with(newJoin(clause.CrossJoin, table))
 }
 
-// OrderBy calls ORDER BY clause.
-func (s *SelectStmt) OrderBy(columns ...string) iselect.OrderBy {
-	s.call(&clause.OrderBy{Columns: columns})
-	return s
+// NaturalJoin calls NATURAL JOIN clause.
+func (s *SelectStmt) NaturalJoin(table string) iselect.From {
+	return s.with(newJoin(clause.NaturalJoin, table))
 }
 
-// Join calls (INNER) JOIN clause.
-func (s *SelectStmt) Join(table string) iselect.Join {
-	j := &clause.Join{Type: clause.InnerJoin}
-	j.AddTable(table)
-	s.call(j)
-	return s
+// JoinSub calls (INNER) JOIN clause with subquery.
+func (s *SelectStmt) JoinSub(stmt interfaces.Stmt, alias string) iselect.Join {
+	return s.with(newJoinSub(clause.InnerJoin, stmt, alias, false))
 }
 
-// LeftJoin calls (INNER) JOIN clause.
-func (s *SelectStmt) LeftJoin(table string) iselect.Join {
-	j := &clause.Join{Type: clause.LeftJoin}
-	j.AddTable(table)
-	s.call(j)
-	return s
+// LeftJoinSub calls LEFT JOIN clause with subquery.
+func (s *SelectStmt) LeftJoinSub(stmt interfaces.Stmt, alias string) iselect.Join {
+	return s.with(newJoinSub(clause.LeftJoin, stmt, alias, false))
 }
 
-// RightJoin calls (INNER) JOIN clause.
-func (s *SelectStmt) RightJoin(table string) iselect.Join {
-	j := &clause.Join{Type: clause.RightJoin}
+// JoinLateral calls (INNER) JOIN LATERAL clause with subquery.
+func (s *SelectStmt) JoinLateral(stmt interfaces.Stmt, alias string) iselect.Join {
+	return s.with(newJoinSub(clause.InnerJoin, stmt, alias, true))
+}
+
+// LeftJoinLateral calls LEFT JOIN LATERAL clause with subquery.
+func (s *SelectStmt) LeftJoinLateral(stmt interfaces.Stmt, alias string) iselect.Join {
+	return s.with(newJoinSub(clause.LeftJoin, stmt, alias, true))
+}
+
+// newJoin creates JOIN clause of the type.
+func newJoin(typ clause.JoinType, table string) *clause.Join {
+	j := &clause.Join{Type: typ}
 	j.AddTable(table)
-	s.call(j)
-	return s
+	return j
+}
+
+// newJoinSub creates JOIN clause of the type with subquery.
+func newJoinSub(typ clause.JoinType, stmt interfaces.Stmt, alias string, lateral bool) *clause.Join {
+	j := &clause.Join{Type: typ, Lateral: lateral}
+	j.AddStmt(stmt, alias)
+	return j
 }
 
 // On calls ON clause.
 func (s *SelectStmt) On(expr string, values ...interface{}) iselect.On {
-	s.call(&clause.On{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.On{Expr: expr, Values: values})
+}
+
+// Using calls USING clause.
+func (s *SelectStmt) Using(columns ...string) iselect.On {
+	return s.with(&clause.Using{Columns: columns})
+}
+
+// AndOn calls AND clause which extends the join condition.
+func (s *SelectStmt) AndOn(expr string, values ...interface{}) iselect.On {
+	return s.with(&clause.AndOn{Expr: expr, Values: values})
+}
+
+// OrOn calls OR clause which extends the join condition.
+func (s *SelectStmt) OrOn(expr string, values ...interface{}) iselect.On {
+	return s.with(&clause.OrOn{Expr: expr, Values: values})
 }
 
 // Union calls UNION clause.
 func (s *SelectStmt) Union(stmt interfaces.Stmt) iselect.Union {
-	s.call(&clause.Union{Stmt: stmt, All: false})
-	return s
+	return s.with(&clause.Union{Stmt: stmt, All: false})
 }
 
 // UnionAll calls UNION ALL clause.
 func (s *SelectStmt) UnionAll(stmt interfaces.Stmt) iselect.Union {
-	s.call(&clause.Union{Stmt: stmt, All: true})
-	return s
+	return s.with(&clause.Union{Stmt: stmt, All: true})
+}
+
+// ForUpdate calls FOR UPDATE clause.
+func (s *SelectStmt) ForUpdate() iselect.Lock {
+	return s.with(&clause.Lock{Strength: clause.ForUpdate})
+}
+
+// ForShare calls FOR SHARE clause.
+// If the dialect is MySQL5, LOCK IN SHARE MODE is used instead.
+func (s *SelectStmt) ForShare() iselect.Lock {
+	return s.with(&clause.Lock{Strength: clause.ForShare})
+}
+
+// Of calls OF clause of the row locking clause.
+func (s *SelectStmt) Of(tables ...string) iselect.Of {
+	return s.with(&clause.Of{Tables: tables})
+}
+
+// NoWait calls NOWAIT option of the row locking clause.
+func (s *SelectStmt) NoWait() iselect.NoWait {
+	return s.with(&clause.NoWait{})
+}
+
+// SkipLocked calls SKIP LOCKED option of the row locking clause.
+func (s *SelectStmt) SkipLocked() iselect.NoWait {
+	return s.with(&clause.SkipLocked{})
+}
+
+// Intersect calls INTERSECT clause.
+func (s *SelectStmt) Intersect(stmt interfaces.Stmt) iselect.Union {
+	return s.with(&clause.Intersect{Stmt: stmt, All: false})
+}
+
+// IntersectAll calls INTERSECT ALL clause.
+func (s *SelectStmt) IntersectAll(stmt interfaces.Stmt) iselect.Union {
+	return s.with(&clause.Intersect{Stmt: stmt, All: true})
+}
+
+// Except calls EXCEPT clause.
+func (s *SelectStmt) Except(stmt interfaces.Stmt) iselect.Union {
+	return s.with(&clause.Except{Stmt: stmt, All: false})
+}
+
+// ExceptAll calls EXCEPT ALL clause.
+func (s *SelectStmt) ExceptAll(stmt interfaces.Stmt) iselect.Union {
+	return s.with(&clause.Except{Stmt: stmt, All: true})
 }
 
 // GroupBy calls GROUP BY clause.
@@ -558,14 +2277,26 @@ func (s *SelectStmt) GroupBy(columns ...string) iselect.GroupBy {
 	for _, c := range columns {
 		g.AddColumn(c)
 	}
-	s.call(g)
-	return s
+	return s.with(g)
+}
+
+// GroupByExpr calls GROUP BY clause whose column can be the expression built by expr package.
+func (s *SelectStmt) GroupByExpr(columns ...interface{}) iselect.GroupBy {
+	cols, err := columnsOf(columns)
+	g := new(clause.GroupBy)
+	for _, c := range cols {
+		g.AddColumn(c)
+	}
+	c := s.with(g)
+	if err != nil {
+		c.throw(err)
+	}
+	return c
 }
 
 // Having calls HAVING clause.
 func (s *SelectStmt) Having(expr string, values ...interface{}) iselect.Having {
-	s.call(&clause.Having{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.Having{Expr: expr, Values: values})
 }
 
 // UpdateStmt is UPDATE statement..
@@ -585,15 +2316,140 @@ func newUpdateStmt(conn conn, table string) *UpdateStmt {
 	return s
 }
 
+// Clone returns a copy of UpdateStmt.
+// Clauses called on the copy don't affect the original statement.
+func (s *UpdateStmt) Clone() *UpdateStmt {
+	c := *s
+	c.stmt = s.stmt.clone()
+	return &c
+}
+
+// with returns a copy of UpdateStmt to which the clause is appended.
+func (s *UpdateStmt) with(e interfaces.Clause) *UpdateStmt {
+	c := s.Clone()
+	c.call(e)
+	return c
+}
+
+// CompareWith compares the statements and their models, and returns error if they are not same.
+// The fields of the model tagged with autocreatetime and autoupdatetime are ignored.
+func (s *UpdateStmt) CompareWith(targetStmt interfaces.Stmt) error {
+	if err := s.stmt.CompareWith(targetStmt); err != nil {
+		return err
+	}
+	if t, ok := targetStmt.(*UpdateStmt); ok {
+		return compareModels(s.model, t.model)
+	}
+	return nil
+}
+
 // SQL returns the built SQL string.
 func (s *UpdateStmt) SQL() string {
 	return s.sql(s.buildSQL)
 }
 
+// Fingerprint returns the normalized SQL whose values are replaced by '?' and its hash.
+// It's useful to group the queries by their shape in logs and metrics.
+// If the statement cannot be built, it returns the error.
+func (s *UpdateStmt) Fingerprint() (fingerprint.Fingerprint, error) {
+	return s.fingerprint(s.buildSQL)
+}
+
 // Exec executes SQL statement without mapping to model.
 // If type of conn is gsorm.MockDB, compare statements between called and expected.
+// If the model has the field tagged with version, it returns ErrStaleObject when no row is updated.
+// Otherwise, the version of the model is incremented.
+// The hooks of the model are called before and after the execution.
 func (s *UpdateStmt) Exec() error {
-	return s.exec(s.buildSQL, s)
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeUpdater.BeforeUpdate, AfterUpdater.AfterUpdate, func() error {
+		touchModel(s.conn, s.model, true)
+		return s.execModel()
+	})
+}
+
+// execModel executes SQL statement and increments the version of the model if it exists.
+func (s *UpdateStmt) execModel() error {
+	version, _, err := versionOf(s.model)
+	if err != nil {
+		return err
+	}
+	if !version.IsValid() {
+		return s.exec(s.buildSQL, s)
+	}
+
+	switch conn := s.conn.(type) {
+	case Mock:
+		if _, err := conn.compareWith(s); err != nil {
+			return err
+		}
+	case DB, Tx:
+		var sql internal.SQL
+		if err := s.buildSQL(&sql); err != nil {
+			return err
+		}
+		res, err := conn.Exec(sql.String())
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrStaleObject
+		}
+	default:
+		return xerrors.Errorf("database connection should not be %s", reflect.TypeOf(s.conn).String())
+	}
+
+	incrementVersion(version)
+	return nil
+}
+
+// Query executes SQL statement with RETURNING clause and maps the returned rows to model.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it maps expected values to model.
+// If the model has the field tagged with version, it returns ErrStaleObject when no row is returned.
+// Otherwise, the version of the model is incremented unless it's returned to the model.
+// The hooks of the model are called before and after the execution.
+func (s *UpdateStmt) Query(model interface{}) error {
+	return execWithHooks(&s.stmt, s.conn, s.model, BeforeUpdater.BeforeUpdate, AfterUpdater.AfterUpdate, func() error {
+		touchModel(s.conn, s.model, true)
+		return s.queryModel(model)
+	})
+}
+
+// queryModel executes SQL statement with RETURNING clause and increments the version of the model if it exists.
+func (s *UpdateStmt) queryModel(model interface{}) error {
+	version, _, err := versionOf(s.model)
+	if err != nil {
+		return err
+	}
+	if !version.IsValid() {
+		_, err := s.queryReturning(s.buildSQL, s, model, "")
+		return err
+	}
+
+	current := version.Interface()
+	returned, err := s.queryReturning(s.buildSQL, s, model, "")
+	if err != nil {
+		return err
+	}
+	if !returned {
+		return ErrStaleObject
+	}
+	// If the version column is returned to the model, it's already the incremented one.
+	if version.Interface() == current {
+		incrementVersion(version)
+	}
+	return nil
+}
+
+// Explain executes EXPLAIN statement of the built SQL and returns the execution plan.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it returns expected value.
+func (s *UpdateStmt) Explain(opts ...explain.Option) (*explain.Plan, error) {
+	return s.explain(s.buildSQL, s, opts)
 }
 
 // buildSQL builds SQL statement.
@@ -604,6 +2460,10 @@ func (s *UpdateStmt) buildSQL(sql *internal.SQL) error {
 	}
 	sql.Write(ss.Build())
 
+	if err = s.buildSQLWithJoins(sql); err != nil {
+		return err
+	}
+
 	if s.model != nil {
 		cols := []string{}
 		cols = append(cols, s.modelCols...)
@@ -618,11 +2478,49 @@ func (s *UpdateStmt) buildSQL(sql *internal.SQL) error {
 	return nil
 }
 
+// buildSQLWithJoins builds JOIN clauses which are written before SET clause.
+func (s *UpdateStmt) buildSQLWithJoins(sql *internal.SQL) error {
+	d := dialectOf(s.conn)
+	for _, e := range s.called {
+		switch e := e.(type) {
+		case *clause.Join:
+			if !d.IsMySQL() {
+				return xerrors.Errorf("JOIN in UPDATE is not supported by %s", d)
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.On:
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		}
+	}
+	return nil
+}
+
 // buildSQLWithClauses builds SQL statement from called clauses.
 func (s *UpdateStmt) buildSQLWithClauses(sql *internal.SQL) error {
+	d := dialectOf(s.conn)
 	setCalled := false
-	for _, e := range s.called {
-		switch e := e.(type) {
+	multiTable := false
+	called := s.called
+	version, col, err := versionOf(s.model)
+	if err != nil {
+		return err
+	}
+	if version.IsValid() {
+		if called, err = scope(called, fmt.Sprintf("%s = %v", col, version.Interface())); err != nil {
+			return err
+		}
+	}
+	var conds conditions
+	for _, e := range called {
+		switch e := conds.normalize(e).(type) {
 		case *syntax.RawClause,
 			*clause.Where,
 			*clause.And,
@@ -644,6 +2542,35 @@ func (s *UpdateStmt) buildSQLWithClauses(sql *internal.SQL) error {
 			}
 			sql.Write(ss.Build())
 			setCalled = true
+		case *clause.Join,
+			*clause.On:
+			// These clauses are built by buildSQLWithJoins.
+			multiTable = true
+		case *clause.From:
+			if d.IsMySQL() {
+				return xerrors.Errorf("FROM in UPDATE is not supported by %s", d)
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.OrderBy,
+			*clause.Limit:
+			if err := checkOrderByAndLimit(e, "UPDATE", d, multiTable); err != nil {
+				return err
+			}
+			ss, err := e.Build()
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
+		case *clause.Returning:
+			ss, err := s.build(e)
+			if err != nil {
+				return err
+			}
+			sql.Write(ss.Build())
 		default:
 			return xerrors.Errorf("%s is invalid clause for UPDATE", reflect.TypeOf(e).Elem().String())
 		}
@@ -670,39 +2597,139 @@ func (s *UpdateStmt) buildSQLWithModel(cols []string, model interface{}, sql *in
 
 // RawClause calls the raw string clause.
 func (s *UpdateStmt) RawClause(raw string, values ...interface{}) iupdate.RawClause {
-	s.call(&syntax.RawClause{RawStr: raw, Values: values})
-	return s
+	return s.with(&syntax.RawClause{RawStr: raw, Values: values})
 }
 
 // Model sets model to UpdateStmt.
 func (s *UpdateStmt) Model(model interface{}, columns ...string) iupdate.Model {
-	s.model = model
-	s.modelCols = columns
-	return s
+	c := s.Clone()
+	c.model = model
+	c.modelCols = columns
+	return c
+}
+
+// Join calls INNER JOIN clause.
+// It's supported only by MySQL.
+func (s *UpdateStmt) Join(table string) iupdate.Join {
+	return s.with(newJoin(clause.InnerJoin, table))
+}
+
+// LeftJoin calls LEFT JOIN clause.
+// It's supported only by MySQL.
+func (s *UpdateStmt) LeftJoin(table string) iupdate.Join {
+	return s.with(newJoin(clause.LeftJoin, table))
+}
+
+// RightJoin calls RIGHT JOIN clause.
+// It's supported only by MySQL.
+func (s *UpdateStmt) RightJoin(table string) iupdate.Join {
+	return s.with(newJoin(clause.RightJoin, table))
+}
+
+// On calls ON clause.
+func (s *UpdateStmt) On(expr string, values ...interface{}) iupdate.On {
+	return s.with(&clause.On{Expr: expr, Values: values})
+}
+
+// From calls FROM clause.
+// It's not supported by MySQL.
+func (s *UpdateStmt) From(tables ...string) iupdate.From {
+	f := new(clause.From)
+	for _, t := range tables {
+		f.AddTable(t)
+	}
+	return s.with(f)
+}
+
+// OrderBy calls ORDER BY clause.
+// It's supported only by MySQL for single-table UPDATE.
+func (s *UpdateStmt) OrderBy(columns ...string) iupdate.OrderBy {
+	return s.with(&clause.OrderBy{Columns: columns})
+}
+
+// Limit calls LIMIT clause.
+// It's supported only by MySQL for single-table UPDATE.
+func (s *UpdateStmt) Limit(limit int) iupdate.Limit {
+	return s.with(&clause.Limit{Num: limit})
 }
 
 // Set calls SET clause.
 func (s *UpdateStmt) Set(column string, value interface{}) iupdate.Set {
-	s.call(&clause.Set{Column: column, Value: value})
-	return s
+	return s.with(&clause.Set{Column: column, Value: value})
 }
 
 // Where calls WHERE clause.
 func (s *UpdateStmt) Where(expr string, values ...interface{}) iupdate.Where {
-	s.call(&clause.Where{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.Where{Expr: expr, Values: values})
+}
+
+// Scopes applies the scopes to the statement in order.
+// WHERE clause which is called in the scopes after another condition is built as AND clause.
+func (s *UpdateStmt) Scopes(scopes ...func(iupdate.Scope) iupdate.Scope) iupdate.Where {
+	sc := &updateScope{stmt: s}
+	for _, scope := range scopes {
+		scope(sc)
+	}
+	return sc.stmt
+}
+
+// When applies fn to the statement only if cond is true.
+func (s *UpdateStmt) When(cond bool, fn func(iupdate.Scope) iupdate.Scope) iupdate.Where {
+	if !cond {
+		return s
+	}
+	return s.Scopes(fn)
+}
+
+// updateScope is iupdate.Scope which appends the conditions to the statement.
+type updateScope struct {
+	stmt *UpdateStmt
+}
+
+// Where calls WHERE clause.
+func (sc *updateScope) Where(expr string, values ...interface{}) iupdate.Scope {
+	sc.stmt = sc.stmt.with(&clause.Where{Expr: expr, Values: values})
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the struct model like (*UpdateStmt).WhereModel.
+func (sc *updateScope) WhereModel(model interface{}, zeroColumns ...string) iupdate.Scope {
+	sc.stmt = sc.stmt.WhereModel(model, zeroColumns...).(*UpdateStmt)
+	return sc
+}
+
+// WhereModel calls WHERE clause which is built from the exported fields of the struct model.
+// Each field whose value is not zero is built as equality condition like "column = value" and they are joined with AND.
+// The zero values of the fields whose columns are included in zeroColumns are also used.
+// The slice field is built as IN condition and the nil pointer field is built as IS NULL condition.
+// The column name is determined by the field tag like the other models.
+// If it's called after another condition, it's built as AND clause.
+func (s *UpdateStmt) WhereModel(model interface{}, zeroColumns ...string) iupdate.Where {
+	e, err := whereModelOf(model, zeroColumns, dialectOf(s.conn))
+	if err != nil {
+		c := s.Clone()
+		c.throw(err)
+		return c
+	}
+	if e == nil {
+		return s
+	}
+	return s.with(e)
 }
 
 // And calls AND clause.
 func (s *UpdateStmt) And(expr string, values ...interface{}) iupdate.And {
-	s.call(&clause.And{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.And{Expr: expr, Values: values})
 }
 
 // Or calls OR clause.
 func (s *UpdateStmt) Or(expr string, values ...interface{}) iupdate.Or {
-	s.call(&clause.Or{Expr: expr, Values: values})
-	return s
+	return s.with(&clause.Or{Expr: expr, Values: values})
+}
+
+// Returning calls RETURNING clause.
+func (s *UpdateStmt) Returning(columns ...string) iupdate.Returning {
+	return s.with(&clause.Returning{Columns: columns})
 }
 
 // rawStmt is raw string statement.
@@ -723,11 +2750,22 @@ func (s *rawStmt) SQL() string {
 	return s.sql(s.buildSQL)
 }
 
+// Fingerprint returns the normalized SQL whose values are replaced by '?' and its hash.
+// It's useful to group the queries by their shape in logs and metrics.
+// If the statement cannot be built, it returns the error.
+func (s *rawStmt) Fingerprint() (fingerprint.Fingerprint, error) {
+	return s.fingerprint(s.buildSQL)
+}
+
 // Query executes SQL statement with mapping to model.
 // If type of (*SelectStmt).conn is gsorm.MockDB, compare statements between called and expected.
 // Then, it maps expected values to model.
+// AfterFind of the model is called after the mapping.
 func (s *rawStmt) Query(model interface{}) error {
-	return s.query(s.buildSQL, s, model)
+	if err := s.query(s.buildSQL, s, model); err != nil {
+		return err
+	}
+	return runHooks(s.conn, model, AfterFinder.AfterFind)
 }
 
 // Exec executed SQL statement without mapping to model.
@@ -736,6 +2774,13 @@ func (s *rawStmt) Exec() error {
 	return s.exec(s.buildSQL, s)
 }
 
+// Explain executes EXPLAIN statement of the built SQL and returns the execution plan.
+// If type of conn is gsorm.MockDB, compare statements between called and expected.
+// Then, it returns expected value.
+func (s *rawStmt) Explain(opts ...explain.Option) (*explain.Plan, error) {
+	return s.explain(s.buildSQL, s, opts)
+}
+
 // Migrate executes database migration.
 func (s *rawStmt) Migrate() error {
 	if len(s.errors) > 0 {
@@ -1,10 +1,21 @@
 package gsorm_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/expr"
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/interfaces/idelete"
+	"github.com/champon1020/gsorm/interfaces/iselect"
+	"github.com/champon1020/gsorm/interfaces/iupdate"
 	"github.com/google/go-cmp/cmp"
 	"gotest.tools/v3/assert"
 )
@@ -50,6 +61,85 @@ func TestStatement_ExecWithMock(t *testing.T) {
 	}
 }
 
+func TestStatement_ConcurrentUse(t *testing.T) {
+	selectBase := gsorm.Select(nil, "e.emp_no", "s.salary").
+		From("employees AS e").
+		Join("salaries AS s").
+		On("e.emp_no = s.emp_no")
+	updateBase := gsorm.Update(nil, "employees").
+		Set("first_name", "Hanako")
+	failingBase := gsorm.Update(nil, "employees").
+		Set("last_name", invalidValuer("Yamada"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			sel := selectBase.Where("e.emp_no = ?", i).And("s.salary > ?", i*100).(*gsorm.SelectStmt)
+			expected := fmt.Sprintf("SELECT e.emp_no, s.salary FROM employees AS e "+
+				"INNER JOIN salaries AS s ON e.emp_no = s.emp_no WHERE e.emp_no = %d AND (s.salary > %d)", i, i*100)
+			if actual := sel.SQL(); actual != expected {
+				t.Errorf("expected: %s\nactual:   %s", expected, actual)
+			}
+
+			upd := updateBase.Set("last_name", "Suzuki").Where("emp_no = ?", i).(*gsorm.UpdateStmt)
+			expected = fmt.Sprintf("UPDATE employees SET first_name = 'Hanako', last_name = 'Suzuki' WHERE emp_no = %d", i)
+			if actual := upd.SQL(); actual != expected {
+				t.Errorf("expected: %s\nactual:   %s", expected, actual)
+			}
+
+			// Building the failing statement doesn't modify the shared one.
+			if actual := failingBase.(*gsorm.UpdateStmt).SQL(); actual != "invalid value" {
+				t.Errorf("expected: invalid value\nactual:   %s", actual)
+			}
+			fail := failingBase.Where("emp_no = ?", i).(*gsorm.UpdateStmt)
+			if _, err := fail.Fingerprint(); err == nil || err.Error() != "invalid value" {
+				t.Errorf("expected: invalid value\nactual:   %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, "SELECT e.emp_no, s.salary FROM employees AS e INNER JOIN salaries AS s ON e.emp_no = s.emp_no",
+		selectBase.(*gsorm.SelectStmt).SQL())
+	assert.Equal(t, "UPDATE employees SET first_name = 'Hanako'", updateBase.(*gsorm.UpdateStmt).SQL())
+	assert.Equal(t, 0, len(failingBase.(*gsorm.UpdateStmt).ExportedGetErrors()))
+}
+
+func TestSelectStmt_Clone(t *testing.T) {
+	base := gsorm.Select(nil, "emp_no").From("employees").
+		Where("emp_no > ?", 1000).
+		And("emp_no < ?", 2000).(*gsorm.SelectStmt)
+
+	s1 := base.OrderBy("emp_no").(*gsorm.SelectStmt)
+	s2 := base.Limit(10).(*gsorm.SelectStmt)
+	clone := base.Clone()
+	clone.Or("emp_no = ?", 1)
+
+	assert.Equal(t, "SELECT emp_no FROM employees WHERE emp_no > 1000 AND (emp_no < 2000)", base.SQL())
+	assert.Equal(t, "SELECT emp_no FROM employees WHERE emp_no > 1000 AND (emp_no < 2000) ORDER BY emp_no", s1.SQL())
+	assert.Equal(t, "SELECT emp_no FROM employees WHERE emp_no > 1000 AND (emp_no < 2000) LIMIT 10", s2.SQL())
+	assert.Equal(t, "SELECT emp_no FROM employees WHERE emp_no > 1000 AND (emp_no < 2000)", clone.SQL())
+}
+
+func TestInsertStmt_Clone(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	model1 := Employee{ID: 1001, FirstName: "Taro"}
+	model2 := Employee{ID: 1002, FirstName: "Jiro"}
+
+	base := gsorm.Insert(nil, "employees", "emp_no", "first_name")
+	s1 := base.Model(&model1).(*gsorm.InsertStmt)
+	s2 := base.Model(&model2).(*gsorm.InsertStmt)
+
+	assert.Equal(t, "INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro')", s1.SQL())
+	assert.Equal(t, "INSERT INTO employees (emp_no, first_name) VALUES (1002, 'Jiro')", s2.SQL())
+}
+
 func TestDeleteStmt_RawClause(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.DeleteStmt
@@ -364,6 +454,301 @@ func TestDeleteStmt_Or(t *testing.T) {
 	}
 }
 
+func TestDeleteStmt_WhereModel(t *testing.T) {
+	type EmployeeFilter struct {
+		EmpNo    []int `gsorm:"emp_no"`
+		LastName string
+	}
+
+	actual := gsorm.Delete(nil).From("employees").
+		WhereModel(&EmployeeFilter{EmpNo: []int{1001, 1002}}).(*gsorm.DeleteStmt).SQL()
+	assert.Equal(t, `DELETE FROM employees WHERE emp_no IN (1001, 1002)`, actual)
+}
+
+func TestDeleteStmt_Scopes(t *testing.T) {
+	byEmpNo := func(empNo int) func(idelete.Scope) idelete.Scope {
+		return func(w idelete.Scope) idelete.Scope {
+			return w.Where("emp_no = ?", empNo)
+		}
+	}
+
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(nil).From("employees").
+				Scopes(byEmpNo(1001)).(*gsorm.DeleteStmt),
+			`DELETE FROM employees WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.Delete(nil).From("employees").
+				Where("last_name = ?", "Yamada").
+				When(true, byEmpNo(1001)).
+				When(false, byEmpNo(1002)).(*gsorm.DeleteStmt),
+			`DELETE FROM employees WHERE last_name = 'Yamada' AND (emp_no = 1001)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_Join(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(nil, "e").From("employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Where("d.dept_no = ?", "d001").(*gsorm.DeleteStmt),
+			`DELETE e FROM employees AS e INNER JOIN dept_emp AS d ON e.emp_no = d.emp_no WHERE d.dept_no = 'd001'`,
+		},
+		{
+			gsorm.Delete(nil, "e", "s").From("employees AS e").
+				LeftJoin("salaries AS s").
+				On("e.emp_no = s.emp_no").
+				Where("e.hire_date < ?", "1990-01-01").(*gsorm.DeleteStmt),
+			`DELETE e, s FROM employees AS e LEFT JOIN salaries AS s ON e.emp_no = s.emp_no ` +
+				`WHERE e.hire_date < '1990-01-01'`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_Using(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(pg).From("employees AS e").
+				Using("dept_emp AS d").
+				Where("e.emp_no = d.emp_no").
+				And("d.dept_no = ?", "d001").(*gsorm.DeleteStmt),
+			`DELETE FROM employees AS e USING dept_emp AS d WHERE e.emp_no = d.emp_no AND (d.dept_no = 'd001')`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_OrderBy(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(nil).From("salaries").
+				Where("to_date < ?", "2000-01-01").
+				OrderBy("to_date").
+				Limit(1000).(*gsorm.DeleteStmt),
+			`DELETE FROM salaries WHERE to_date < '2000-01-01' ORDER BY to_date LIMIT 1000`,
+		},
+		{
+			gsorm.Delete(nil).From("salaries").
+				Limit(1000).(*gsorm.DeleteStmt),
+			`DELETE FROM salaries LIMIT 1000`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_Fail(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(pg, "e").From("employees AS e").(*gsorm.DeleteStmt),
+			"DELETE with target tables is not supported by postgres",
+		},
+		{
+			gsorm.Delete(pg).From("employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").(*gsorm.DeleteStmt),
+			"JOIN in DELETE is not supported by postgres",
+		},
+		{
+			gsorm.Delete(nil).From("employees").
+				Using("dept_emp").(*gsorm.DeleteStmt),
+			"USING is not supported by mysql",
+		},
+		{
+			gsorm.Delete(pg).From("salaries").
+				OrderBy("to_date").(*gsorm.DeleteStmt),
+			"ORDER BY in DELETE is not supported by postgres",
+		},
+		{
+			gsorm.Delete(nil, "e").From("employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Where("d.dept_no = ?", "d001").
+				Limit(10).(*gsorm.DeleteStmt),
+			"LIMIT cannot be used with multiple-table DELETE",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.Expected, err.Error())
+	}
+}
+
+func TestDeleteStmt_Returning(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.Delete(pg).From("employees").
+				Where("emp_no = ?", 1001).
+				Returning("emp_no", "first_name").(*gsorm.DeleteStmt),
+			`DELETE FROM employees WHERE emp_no = 1001 RETURNING emp_no, first_name`,
+		},
+		{
+			gsorm.Delete(pg).From("employees").
+				Returning().(*gsorm.DeleteStmt),
+			`DELETE FROM employees RETURNING *`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_SoftDelete(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		DeletedAt time.Time `gsorm:"softdelete"`
+	}
+	clock := func() time.Time { return time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC) }
+	db := &gsorm.ExportedClockDB{Clock: clock}
+	pg := &gsorm.ExportedClockDB{DB: gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL)), Clock: clock}
+
+	testCases := []struct {
+		Stmt     *gsorm.DeleteStmt
+		Expected string
+	}{
+		{
+			gsorm.DeleteModel(db, &Employee{}).Where("emp_no = ?", 1001).(*gsorm.DeleteStmt),
+			`UPDATE employees SET deleted_at = '2021-04-01 09:00:00' WHERE deleted_at IS NULL AND (emp_no = 1001)`,
+		},
+		{
+			gsorm.DeleteModel(db, &Employee{}).
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).(*gsorm.DeleteStmt),
+			`UPDATE employees SET deleted_at = '2021-04-01 09:00:00' ` +
+				`WHERE deleted_at IS NULL AND (emp_no = 1001 OR (emp_no = 1002))`,
+		},
+		{
+			gsorm.DeleteModel(db, &Employee{}).OrderBy("emp_no").Limit(10).(*gsorm.DeleteStmt),
+			`UPDATE employees SET deleted_at = '2021-04-01 09:00:00' WHERE deleted_at IS NULL ORDER BY emp_no LIMIT 10`,
+		},
+		{
+			gsorm.DeleteModel(pg, &Employee{}).Where("emp_no = ?", 1001).Returning("emp_no").(*gsorm.DeleteStmt),
+			`UPDATE employees SET deleted_at = '2021-04-01 09:00:00' WHERE deleted_at IS NULL AND (emp_no = 1001) RETURNING emp_no`,
+		},
+		{
+			gsorm.DeleteModel(db, &Employee{}).HardDelete().Where("emp_no = ?", 1001).(*gsorm.DeleteStmt),
+			`DELETE FROM employees WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.DeleteModel(nil, &[]genericEmployee{}).Where("emp_no = ?", 1001).(*gsorm.DeleteStmt),
+			`DELETE FROM generic_employees WHERE emp_no = 1001`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestDeleteStmt_SoftDelete_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		DeletedAt time.Time `gsorm:"softdelete"`
+	}
+	s := gsorm.DeleteModel(nil, &Employee{}).
+		Join("dept_emp AS d").
+		On("employees.emp_no = d.emp_no").(*gsorm.DeleteStmt)
+	_, err := s.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "clause.Join cannot be used with soft delete", err.Error())
+}
+
+func TestDeleteStmt_SoftDelete_RawWhere_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		DeletedAt time.Time `gsorm:"softdelete"`
+	}
+	s := gsorm.DeleteModel(nil, &Employee{}).RawClause("WHERE emp_no = ?", 1001).(*gsorm.DeleteStmt)
+	_, err := s.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "deleted_at IS NULL cannot be combined with WHERE clause of RawClause", err.Error())
+}
+
 func TestInsertStmt_RawClause(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.InsertStmt
@@ -503,209 +888,2485 @@ func TestInsertStmt_Model(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_RawClause(t *testing.T) {
+func TestInsertStmt_OnConflict(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	structModel := Employee{ID: 1001, FirstName: "Taro"}
+	structSlice := []Employee{{ID: 1001, FirstName: "Taro"}, {ID: 1002, FirstName: "Jiro"}}
+	mapModel := map[string]interface{}{"emp_no": 1001, "first_name": "Taro"}
+	mapSlice := []map[string]interface{}{
+		{"emp_no": 1001, "first_name": "Taro"},
+		{"emp_no": 1002, "first_name": "Jiro"},
+	}
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	sqlite := gsorm.OpenMock(gsorm.WithDialect(gsorm.SQLite))
+
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.InsertStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).
-				RawClause("RAW").
-				From("table").(*gsorm.SelectStmt),
-			`SELECT * RAW FROM table`,
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict("emp_no").
+				UpdateFromExcluded("first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON DUPLICATE KEY UPDATE first_name = VALUES(first_name)`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				RawClause("RAW").
-				Join("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
-			`SELECT * FROM table RAW ` +
-				`INNER JOIN table2 ON table.column = table2.column`,
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict("emp_no").
+				UpdateFromExcluded("first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON CONFLICT (emp_no) DO UPDATE SET first_name = EXCLUDED.first_name`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				RawClause("RAW").
-				LeftJoin("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
-			`SELECT * FROM table RAW ` +
-				`LEFT JOIN table2 ON table.column = table2.column`,
+			gsorm.Insert(nil, "employees", "emp_no", "first_name", "hire_count").
+				Values(1001, "Taro", 1).
+				OnConflict().
+				DoUpdate("hire_count", 2).
+				UpdateFromExcluded("first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name, hire_count) VALUES (1001, 'Taro', 1) ` +
+				`ON DUPLICATE KEY UPDATE hire_count = 2, first_name = VALUES(first_name)`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				RawClause("RAW").
-				RightJoin("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
-			`SELECT * FROM table RAW ` +
-				`RIGHT JOIN table2 ON table.column = table2.column`,
+			gsorm.Insert(sqlite, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict("emp_no").
+				DoUpdate("first_name", "Jiro").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON CONFLICT (emp_no) DO UPDATE SET first_name = 'Jiro'`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Join("table2").RawClause("RAW").On("table.column = table2.column").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`INNER JOIN table2 RAW ON table.column = table2.column`,
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict().
+				DoNothing().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON DUPLICATE KEY UPDATE emp_no = emp_no`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Join("table2").On("table.column = table2.column").
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict().
+				DoNothing().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON CONFLICT DO NOTHING`,
+		},
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&structModel).
+				OnConflict("emp_no").
+				UpdateFromExcluded("first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON DUPLICATE KEY UPDATE first_name = VALUES(first_name)`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Model(&structSlice).
+				OnConflict("emp_no").
+				UpdateFromExcluded("first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro') ` +
+				`ON CONFLICT (emp_no) DO UPDATE SET first_name = EXCLUDED.first_name`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Model(&mapModel).
+				OnConflict("emp_no").
+				DoNothing().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON CONFLICT (emp_no) DO NOTHING`,
+		},
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&mapSlice).
+				OnConflict("emp_no").
+				UpdateFromExcluded("emp_no", "first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro') ` +
+				`ON DUPLICATE KEY UPDATE emp_no = VALUES(emp_no), first_name = VALUES(first_name)`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Select(gsorm.Select(nil, "emp_no", "first_name").From("new_employees")).
+				OnConflict("emp_no").
+				DoNothing().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) SELECT emp_no, first_name FROM new_employees ` +
+				`ON CONFLICT (emp_no) DO NOTHING`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestInsertStmt_OnConflict_Fail(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	stmt := gsorm.Insert(pg, "employees", "emp_no", "first_name").
+		Values(1001, "Taro").
+		OnConflict().
+		UpdateFromExcluded("first_name").(*gsorm.InsertStmt)
+
+	_, err := stmt.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "conflict target columns are required for DO UPDATE", err.Error())
+}
+
+func TestInsertStmt_Returning(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	structModel := Employee{ID: 1001, FirstName: "Taro"}
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+
+	testCases := []struct {
+		Stmt     *gsorm.InsertStmt
+		Expected string
+	}{
+		{
+			gsorm.Insert(pg, "employees", "first_name").
+				Values("Taro").
+				Returning("emp_no").(*gsorm.InsertStmt),
+			`INSERT INTO employees (first_name) VALUES ('Taro') RETURNING emp_no`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Model(&structModel).
+				Returning().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') RETURNING *`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Values(1001, "Taro").
+				OnConflict("emp_no").
+				UpdateFromExcluded("first_name").
+				Returning("emp_no", "first_name").(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro') ` +
+				`ON CONFLICT (emp_no) DO UPDATE SET first_name = EXCLUDED.first_name RETURNING emp_no, first_name`,
+		},
+		{
+			// RETURNING clause is emulated with LastInsertId on MySQL.
+			gsorm.Insert(nil, "employees", "first_name").
+				Values("Taro").
+				Returning("emp_no").(*gsorm.InsertStmt),
+			`INSERT INTO employees (first_name) VALUES ('Taro')`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestInsertStmt_QueryWithLastInsertID(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	model := Employee{FirstName: "Taro"}
+	db := newFakeDBWithResult(newFakeResult(1001, 1))
+
+	err := gsorm.Insert(db, "employees", "first_name").
+		Model(&model).
+		Returning("emp_no").Query(&model)
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+
+	if diff := cmp.Diff(Employee{ID: 1001, FirstName: "Taro"}, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestInsertStmt_QueryWithLastInsertID_Fail(t *testing.T) {
+	db := newFakeDBWithResult(newFakeResult(1001, 2))
+	testCases := []struct {
+		Stmt     *gsorm.InsertStmt
+		Expected string
+	}{
+		{
+			gsorm.Insert(db, "employees", "first_name").
+				Values("Taro").
+				Values("Jiro").
+				Returning("emp_no").(*gsorm.InsertStmt),
+			"RETURNING on MySQL is only supported for single-row INSERT",
+		},
+		{
+			gsorm.Insert(db, "employees", "first_name").
+				Values("Taro").
+				Returning("emp_no", "first_name").(*gsorm.InsertStmt),
+			"RETURNING on MySQL must have only one column which is mapped to LastInsertId",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var id int
+		err := testCase.Stmt.Query(&id)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.Expected, err.Error())
+	}
+}
+
+func TestInsertStmt_QueryWithMock(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	model := Employee{FirstName: "Taro"}
+
+	mock := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	expectedReturn := Employee{ID: 1001, FirstName: "Taro"}
+	mock.ExpectWithReturn(gsorm.Insert(nil, "employees", "first_name").Model(&model).Returning(), expectedReturn)
+
+	err := gsorm.Insert(mock, "employees", "first_name").Model(&model).Returning().Query(&model)
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+
+	if diff := cmp.Diff(expectedReturn, model); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestInsertStmt_AutoTime(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+		UpdatedAt int64     `gsorm:"autoupdatetime"`
+	}
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+	fdb := newFakeDBWithResult(newFakeResult(0, 2)).(*fakeDB)
+	db := &gsorm.ExportedClockDB{DB: fdb, Clock: func() time.Time { return now }}
+
+	model := []Employee{
+		{EmpNo: 1001},
+		{EmpNo: 1002, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	s := gsorm.InsertModel(db, &model)
+
+	// Building SQL doesn't modify the model.
+	_ = s.(*gsorm.InsertStmt).SQL()
+	assert.DeepEqual(t, []Employee{
+		{EmpNo: 1001},
+		{EmpNo: 1002, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, model)
+
+	if err := s.Exec(); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	assert.DeepEqual(t, []string{`INSERT INTO employees (emp_no, created_at, updated_at) VALUES ` +
+		`(1001, '2021-04-01 09:00:00', 1617267600), (1002, '2020-01-01 00:00:00', 1617267600)`}, fdb.execs)
+	assert.DeepEqual(t, []Employee{
+		{EmpNo: 1001, CreatedAt: now, UpdatedAt: now.Unix()},
+		{EmpNo: 1002, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), UpdatedAt: now.Unix()},
+	}, model)
+}
+
+func TestInsertStmt_Valuer(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		LastName  *string
+		Gender    sql.NullString
+		UpdatedAt *time.Time `gsorm:"autoupdatetime"`
+	}
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+	fdb := newFakeDBWithResult(newFakeResult(0, 2)).(*fakeDB)
+	db := &gsorm.ExportedClockDB{DB: fdb, Clock: func() time.Time { return now }}
+
+	lastName := "Yamada"
+	model := []Employee{
+		{EmpNo: 1001, LastName: &lastName, Gender: sql.NullString{String: "M", Valid: true}},
+		{EmpNo: 1002},
+	}
+	if err := gsorm.InsertModel(db, &model).Exec(); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	assert.DeepEqual(t, []string{`INSERT INTO employees (emp_no, last_name, gender, updated_at) VALUES ` +
+		`(1001, 'Yamada', 'M', '2021-04-01 09:00:00'), (1002, NULL, NULL, '2021-04-01 09:00:00')`}, fdb.execs)
+	assert.DeepEqual(t, &now, model[1].UpdatedAt)
+}
+
+type invalidValuer string
+
+func (v invalidValuer) Value() (driver.Value, error) {
+	return nil, errors.New("invalid value")
+}
+
+func TestStatement_Valuer_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo    int
+		LastName invalidValuer
+	}
+	testCases := []interfaces.Stmt{
+		gsorm.InsertModel(nil, &Employee{EmpNo: 1001, LastName: "Yamada"}).(*gsorm.InsertStmt),
+		gsorm.InsertModel(nil, &[]Employee{{EmpNo: 1001, LastName: "Yamada"}}).(*gsorm.InsertStmt),
+		gsorm.Insert(nil, "employees", "emp_no", "last_name").Values(1001, invalidValuer("Yamada")).(*gsorm.InsertStmt),
+		gsorm.UpdateModel(nil, &Employee{EmpNo: 1001, LastName: "Yamada"}).(*gsorm.UpdateStmt),
+		gsorm.Update(nil, "employees").Set("last_name", invalidValuer("Yamada")).(*gsorm.UpdateStmt),
+		gsorm.Select(nil).From("employees").Where("last_name = ?", invalidValuer("Yamada")).(*gsorm.SelectStmt),
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.(interfaces.Fingerprinter).Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred: %s", testCase.String())
+			continue
+		}
+		assert.Equal(t, "invalid value", err.Error())
+	}
+}
+
+func TestInsertStmt_NestedModel(t *testing.T) {
+	type Base struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+	}
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type Employee struct {
+		Base
+		FirstName string
+		Address   Address `gsorm:"prefix=addr_"`
+		Dept      Department
+	}
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+	fdb := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	db := &gsorm.ExportedClockDB{DB: fdb, Clock: func() time.Time { return now }}
+
+	model := Employee{
+		Base:      Base{EmpNo: 1001},
+		FirstName: "Taro",
+		Address:   Address{City: "Tokyo", Zip: "100-0001"},
+		Dept:      Department{DeptNo: "d001"},
+	}
+	if err := gsorm.InsertModel(db, &model).Exec(); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+	}
+	assert.DeepEqual(t, []string{`INSERT INTO employees (emp_no, created_at, first_name, addr_city, addr_zip) ` +
+		`VALUES (1001, '2021-04-01 09:00:00', 'Taro', 'Tokyo', '100-0001')`}, fdb.execs)
+	assert.Equal(t, now, model.CreatedAt)
+}
+
+func TestInsertStmt_Batch(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	structSlice := []Employee{{ID: 1001, FirstName: "Taro"}, {ID: 1002, FirstName: "Jiro"}, {ID: 1003, FirstName: "Saburo"}}
+	mapSlice := []map[string]interface{}{
+		{"emp_no": 1001, "first_name": "Taro"},
+		{"emp_no": 1002, "first_name": "Jiro"},
+	}
+	structModel := Employee{ID: 1001, FirstName: "Taro"}
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+
+	testCases := []struct {
+		Stmt     *gsorm.InsertStmt
+		Expected string
+	}{
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&structSlice).
+				Batch(2).(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro'); ` +
+				`INSERT INTO employees (emp_no, first_name) VALUES (1003, 'Saburo')`,
+		},
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&mapSlice).
+				Batch(1).(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'); ` +
+				`INSERT INTO employees (emp_no, first_name) VALUES (1002, 'Jiro')`,
+		},
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&structSlice).
+				Batch(0).(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro'), (1003, 'Saburo')`,
+		},
+		{
+			gsorm.Insert(nil, "employees", "emp_no", "first_name").
+				Model(&structModel).
+				Batch(2).(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro')`,
+		},
+		{
+			gsorm.Insert(pg, "employees", "emp_no", "first_name").
+				Model(&structSlice).
+				Batch(2).
+				OnConflict("emp_no").
+				DoNothing().(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro'), (1002, 'Jiro') ON CONFLICT (emp_no) DO NOTHING; ` +
+				`INSERT INTO employees (emp_no, first_name) VALUES (1003, 'Saburo') ON CONFLICT (emp_no) DO NOTHING`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestInsertStmt_BatchExec(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	model := []Employee{{ID: 1001, FirstName: "Taro"}, {ID: 1002, FirstName: "Jiro"}, {ID: 1003, FirstName: "Saburo"}}
+
+	{
+		db := newFakeDBWithResult(newFakeResult(0, 2)).(*fakeDB)
+		n, err := gsorm.Insert(db, "employees", "emp_no", "first_name").Model(&model).Batch(2).ExecBatch()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			return
+		}
+		assert.Equal(t, 2, len(db.execs))
+		assert.Equal(t, int64(4), n)
+		assert.Assert(t, db.tx == nil)
+	}
+	{
+		db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+		n, err := gsorm.Insert(db, "employees", "emp_no", "first_name").Model(&model).Batch(1).InTransaction().ExecBatch()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			return
+		}
+		assert.Equal(t, 3, len(db.execs))
+		assert.Equal(t, int64(3), n)
+		assert.Equal(t, true, db.tx.committed)
+	}
+	{
+		mock := gsorm.OpenMock()
+		mock.Expect(gsorm.Insert(nil, "employees", "emp_no", "first_name").Model(&model).Batch(2))
+		n, err := gsorm.Insert(mock, "employees", "emp_no", "first_name").Model(&model).Batch(2).ExecBatch()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			return
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %v", err)
+		}
+		assert.Equal(t, int64(3), n)
+	}
+	{
+		e := model[0]
+		mock := gsorm.OpenMock()
+		mock.Expect(gsorm.Insert(nil, "employees", "emp_no", "first_name").Model(&e).Batch(10))
+		n, err := gsorm.Insert(mock, "employees", "emp_no", "first_name").Model(&e).Batch(10).ExecBatch()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			return
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %v", err)
+		}
+		assert.Equal(t, int64(1), n)
+	}
+	{
+		mock := gsorm.OpenMock()
+		mock.Expect(gsorm.Insert(nil, "employees", "emp_no", "first_name").Model(nil).Batch(10))
+		err := gsorm.Insert(mock, "employees", "emp_no", "first_name").Model(nil).Batch(10).Exec()
+		assert.ErrorContains(t, err, "model is required for batch insert")
+	}
+}
+
+func TestInsertStmt_Batch_DefaultSize(t *testing.T) {
+	type Employee struct {
+		EmpNo int
+	}
+	model := make([]Employee, 1001)
+	for i := range model {
+		model[i].EmpNo = i
+	}
+
+	db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+	if err := gsorm.InsertModel(db, &model).Batch(0).Exec(); err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+	assert.Equal(t, 2, len(db.execs))
+	assert.Equal(t, "INSERT INTO employees (emp_no) VALUES (1000)", db.execs[1])
+}
+
+func TestSelectStmt_RawClause(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).
 				RawClause("RAW").
-				Where("id = ?", 10).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`INNER JOIN table2 ON table.column = table2.column ` +
-				`RAW WHERE id = 10`,
+				From("table").(*gsorm.SelectStmt),
+			`SELECT * RAW FROM table`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				RawClause("RAW").
+				Join("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
+			`SELECT * FROM table RAW ` +
+				`INNER JOIN table2 ON table.column = table2.column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				RawClause("RAW").
+				LeftJoin("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
+			`SELECT * FROM table RAW ` +
+				`LEFT JOIN table2 ON table.column = table2.column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				RawClause("RAW").
+				RightJoin("table2").On("table.column = table2.column").(*gsorm.SelectStmt),
+			`SELECT * FROM table RAW ` +
+				`RIGHT JOIN table2 ON table.column = table2.column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Join("table2").RawClause("RAW").On("table.column = table2.column").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`INNER JOIN table2 RAW ON table.column = table2.column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Join("table2").On("table.column = table2.column").
+				RawClause("RAW").
+				Where("id = ?", 10).(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`INNER JOIN table2 ON table.column = table2.column ` +
+				`RAW WHERE id = 10`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Where("id = ?", 10).
+				RawClause("RAW").
+				And("name = ?", "Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`WHERE id = 10 RAW AND (name = 'Taro')`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Where("id = ?", 10).
+				RawClause("RAW").
+				Or("name = ?", "Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`WHERE id = 10 RAW OR (name = 'Taro')`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Where("id = ?", 10).
+				And("name = ?", "Taro").
+				RawClause("RAW").
+				GroupBy("column").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`WHERE id = 10 AND (name = 'Taro') ` +
+				`RAW GROUP BY column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Where("id = ?", 10).
+				Or("name = ?", "Taro").
+				RawClause("RAW").
+				GroupBy("column").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`WHERE id = 10 OR (name = 'Taro') ` +
+				`RAW GROUP BY column`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				GroupBy("column").
+				RawClause("RAW").
+				Having("SUM(id) = ?", 10).(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`GROUP BY column ` +
+				`RAW HAVING SUM(id) = 10`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Having("SUM(id) = ?", 10).
+				RawClause("RAW").
+				Union(gsorm.Select(nil).From("table2")).(*gsorm.SelectStmt),
+			`(SELECT * FROM table ` +
+				`HAVING SUM(id) = 10 ` +
+				`RAW) UNION (SELECT * FROM table2)`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Having("SUM(id) = ?", 10).
+				RawClause("RAW").
+				UnionAll(gsorm.Select(nil).From("table2")).(*gsorm.SelectStmt),
+			`(SELECT * FROM table ` +
+				`HAVING SUM(id) = 10 ` +
+				`RAW) UNION ALL (SELECT * FROM table2)`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Union(gsorm.Select(nil).From("table2")).
+				RawClause("RAW").
+				OrderBy("id").(*gsorm.SelectStmt),
+			`(SELECT * FROM table) ` +
+				`UNION (SELECT * FROM table2) ` +
+				`RAW ORDER BY id`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				UnionAll(gsorm.Select(nil).From("table2")).
+				RawClause("RAW").
+				OrderBy("id").(*gsorm.SelectStmt),
+			`(SELECT * FROM table) ` +
+				`UNION ALL (SELECT * FROM table2) ` +
+				`RAW ORDER BY id`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				OrderBy("id").
+				RawClause("RAW").
+				Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`ORDER BY id ` +
+				`RAW LIMIT 10`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Limit(10).
+				RawClause("RAW").
+				Offset(5).(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`LIMIT 10 RAW OFFSET 5`,
+		},
+		{
+			gsorm.Select(nil).
+				From("table").
+				Limit(10).
+				Offset(5).
+				RawClause("RAW").(*gsorm.SelectStmt),
+			`SELECT * FROM table ` +
+				`LIMIT 10 OFFSET 5 RAW`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Columns(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+		LastName  string `json:"last_name,omitempty"`
+		note      string
+	}
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.SelectModel(nil, &[]Employee{}).From("employees").(*gsorm.SelectStmt),
+			`SELECT emp_no, first_name, last_name FROM employees`,
+		},
+		{
+			gsorm.Select(nil).Columns(Employee{}).From("employees").(*gsorm.SelectStmt),
+			`SELECT emp_no, first_name, last_name FROM employees`,
+		},
+		{
+			gsorm.Select(nil, "s.salary").Columns([]*Employee{}).From("employees AS e").
+				Join("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT s.salary, e.emp_no, e.first_name, e.last_name FROM employees AS e ` +
+				`INNER JOIN salaries AS s ON e.emp_no = s.emp_no`,
+		},
+		{
+			gsorm.SelectModel(nil, &Employee{}).From("employees", "dept_emp").
+				Where("employees.emp_no = dept_emp.emp_no").(*gsorm.SelectStmt),
+			`SELECT employees.emp_no, employees.first_name, employees.last_name FROM employees, dept_emp ` +
+				`WHERE employees.emp_no = dept_emp.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_NestedModel(t *testing.T) {
+	type Base struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+	}
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type Employee struct {
+		Base
+		FirstName string
+		Address   Address `gsorm:"prefix=addr_"`
+		Dept      Department
+	}
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("created_at", reflect.TypeOf(time.Time{})),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+		newFakeColumn("addr_city", reflect.TypeOf("")),
+		newFakeColumn("addr_zip", reflect.TypeOf("")),
+	}
+	createdAt := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001, createdAt, "Taro", "Tokyo", "100-0001"}})).(*fakeDB)
+
+	var model []Employee
+	err := gsorm.SelectModel(db, &model).From("employees").
+		WhereModel(&Employee{Address: Address{City: "Tokyo"}}).Query(&model)
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.DeepEqual(t, []string{
+		`SELECT emp_no, created_at, first_name, addr_city, addr_zip FROM employees WHERE addr_city = 'Tokyo'`,
+	}, db.queries)
+	assert.DeepEqual(t, []Employee{{
+		Base:      Base{EmpNo: 1001, CreatedAt: createdAt},
+		FirstName: "Taro",
+		Address:   Address{City: "Tokyo", Zip: "100-0001"},
+	}}, model)
+}
+
+func TestStatement_EmbeddedPointerModel(t *testing.T) {
+	type Base struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+	}
+	type Employee struct {
+		*Base
+		FirstName string
+		Manager   *Employee
+	}
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	// INSERT allocates the nil embedded struct to fill the timestamp.
+	{
+		fdb := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+		db := &gsorm.ExportedClockDB{DB: fdb, Clock: func() time.Time { return now }}
+		model := Employee{FirstName: "Taro"}
+		if err := gsorm.InsertModel(db, &model).Exec(); err != nil {
+			t.Errorf("Error was occurred: %v", err)
+		}
+		assert.DeepEqual(t, []string{`INSERT INTO employees (emp_no, created_at, first_name) ` +
+			`VALUES (0, '2021-04-01 09:00:00', 'Taro')`}, fdb.execs)
+		assert.DeepEqual(t, &Base{CreatedAt: now}, model.Base)
+	}
+
+	// The fields of the nil embedded struct are regarded as zero value.
+	{
+		model := Employee{FirstName: "Taro"}
+		actual := gsorm.UpdateModel(nil, &model).WhereModel(&Employee{FirstName: "Taro"}).(*gsorm.UpdateStmt).SQL()
+		assert.Equal(t, `UPDATE employees SET emp_no = 0, first_name = 'Taro' WHERE first_name = 'Taro'`, actual)
+		assert.Assert(t, model.Base == nil)
+	}
+
+	// SELECT allocates the nil embedded struct to scan the columns.
+	{
+		ct := []gsorm.ExportedIColumnType{
+			newFakeColumn("emp_no", reflect.TypeOf(0)),
+			newFakeColumn("created_at", reflect.TypeOf(time.Time{})),
+			newFakeColumn("first_name", reflect.TypeOf("")),
+		}
+		db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001, now, "Taro"}})).(*fakeDB)
+		var model []Employee
+		if err := gsorm.SelectModel(db, &model).From("employees").Query(&model); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			return
+		}
+		assert.DeepEqual(t, []string{`SELECT emp_no, created_at, first_name FROM employees`}, db.queries)
+		assert.DeepEqual(t, []Employee{{Base: &Base{EmpNo: 1001, CreatedAt: now}, FirstName: "Taro"}}, model)
+	}
+}
+
+func TestSelectStmt_Columns_Fail(t *testing.T) {
+	model := []map[string]interface{}{}
+	err := gsorm.SelectModel(nil, &model).From("employees").Query(&model)
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "map[string]interface {} is invalid type for model columns", err.Error())
+}
+
+func TestSelectStmt_SoftDelete(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		DeletedAt *time.Time `gsorm:"deleted_at,softdelete"`
+	}
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.SelectModel(nil, &[]Employee{}).From("employees").(*gsorm.SelectStmt),
+			`SELECT emp_no, deleted_at FROM employees WHERE deleted_at IS NULL`,
+		},
+		{
+			gsorm.SelectModel(nil, &[]Employee{}).From("employees").
+				Where("emp_no > ?", 1001).
+				Or("emp_no < ?", 10).
+				OrderBy("emp_no").(*gsorm.SelectStmt),
+			`SELECT emp_no, deleted_at FROM employees WHERE deleted_at IS NULL AND (emp_no > 1001 OR (emp_no < 10)) ORDER BY emp_no`,
+		},
+		{
+			gsorm.SelectModel(nil, &[]Employee{}).From("employees AS e").
+				Join("salaries AS s").On("e.emp_no = s.emp_no").
+				GroupBy("e.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, e.deleted_at FROM employees AS e INNER JOIN salaries AS s ON e.emp_no = s.emp_no ` +
+				`WHERE e.deleted_at IS NULL GROUP BY e.emp_no`,
+		},
+		{
+			gsorm.SelectModel(nil, &[]Employee{}).Unscoped().From("employees").
+				Where("emp_no = ?", 1001).(*gsorm.SelectStmt),
+			`SELECT emp_no, deleted_at FROM employees WHERE emp_no = 1001`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_From(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no").From("employees").(*gsorm.SelectStmt),
+			`SELECT emp_no FROM employees`,
+		},
+		{
+			gsorm.Select(nil, "emp_no").From("employees AS e").(*gsorm.SelectStmt),
+			`SELECT emp_no FROM employees AS e`,
+		},
+		{
+			gsorm.Select(nil, "emp_no").From("employees as e").(*gsorm.SelectStmt),
+			`SELECT emp_no FROM employees AS e`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").From("employees", "departments").(*gsorm.SelectStmt),
+			`SELECT emp_no, dept_no FROM employees, departments`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Join(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				Join("dept_manager AS d").
+				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`INNER JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				Join("dept_manager AS d").On("e.emp_no = d.emp_no").
+				LeftJoin("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`INNER JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
+				`LEFT JOIN salaries AS s ON e.emp_no = s.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+func TestSelectStmt_LeftJoin(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				LeftJoin("dept_manager AS d").
+				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`LEFT JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				LeftJoin("dept_manager AS d").On("e.emp_no = d.emp_no").
+				RightJoin("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`LEFT JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
+				`RIGHT JOIN salaries AS s ON e.emp_no = s.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+func TestSelectStmt_RightJoin(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				RightJoin("dept_manager AS d").
+				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`RIGHT JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				RightJoin("dept_manager AS d").On("e.emp_no = d.emp_no").
+				Join("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`RIGHT JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
+				`INNER JOIN salaries AS s ON e.emp_no = s.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+func TestSelectStmt_FullJoin(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(pg, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				FullJoin("dept_manager AS d").
+				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`FULL OUTER JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_FullJoin_Fail(t *testing.T) {
+	s := gsorm.Select(nil, "e.emp_no", "d.dept_no").
+		From("employees AS e").
+		FullJoin("dept_manager AS d").
+		On("e.emp_no = d.emp_no").(*gsorm.SelectStmt)
+	_, err := s.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "FULL OUTER JOIN is not supported by mysql", err.Error())
+}
+
+func TestSelectStmt_CrossJoin(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				CrossJoin("departments AS d").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e CROSS JOIN departments AS d`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				CrossJoin("departments AS d").
+				Where("e.emp_no = ?", 1001).(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e CROSS JOIN departments AS d ` +
+				`WHERE e.emp_no = 1001`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_NaturalJoin(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").
+				From("employees").
+				NaturalJoin("dept_manager").(*gsorm.SelectStmt),
+			`SELECT emp_no, dept_no FROM employees NATURAL JOIN dept_manager`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Using(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").
+				From("employees").
+				Join("dept_manager").
+				Using("emp_no").(*gsorm.SelectStmt),
+			`SELECT emp_no, dept_no FROM employees INNER JOIN dept_manager USING (emp_no)`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").
+				From("dept_emp").
+				LeftJoin("dept_manager").
+				Using("emp_no", "dept_no").(*gsorm.SelectStmt),
+			`SELECT emp_no, dept_no FROM dept_emp LEFT JOIN dept_manager USING (emp_no, dept_no)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_JoinSub(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "s.salary").
+				From("employees AS e").
+				JoinSub(gsorm.Select(nil, "emp_no", "MAX(salary) AS salary").From("salaries").GroupBy("emp_no"), "s").
+				On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, s.salary FROM employees AS e ` +
+				`INNER JOIN (SELECT emp_no, MAX(salary) AS salary FROM salaries GROUP BY emp_no) AS s ` +
+				`ON e.emp_no = s.emp_no`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "s.salary").
+				From("employees AS e").
+				LeftJoinSub(gsorm.Select(nil, "emp_no", "salary").From("salaries"), "s").
+				On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, s.salary FROM employees AS e ` +
+				`LEFT JOIN (SELECT emp_no, salary FROM salaries) AS s ON e.emp_no = s.emp_no`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "s.salary").
+				From("employees AS e").
+				JoinLateral(gsorm.Select(nil, "salary").From("salaries").
+					Where("salaries.emp_no = e.emp_no").OrderBy("from_date DESC").Limit(1), "s").
+				On("TRUE").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, s.salary FROM employees AS e ` +
+				`INNER JOIN LATERAL (SELECT salary FROM salaries WHERE salaries.emp_no = e.emp_no ` +
+				`ORDER BY from_date DESC LIMIT 1) AS s ON TRUE`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "s.salary").
+				From("employees AS e").
+				LeftJoinLateral(gsorm.Select(nil, "salary").From("salaries").
+					Where("salaries.emp_no = e.emp_no"), "s").
+				On("TRUE").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, s.salary FROM employees AS e ` +
+				`LEFT JOIN LATERAL (SELECT salary FROM salaries WHERE salaries.emp_no = e.emp_no) AS s ON TRUE`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_AndOn(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				AndOn("d.to_date > ?", "NOW()").
+				AndOn("d.dept_no = ?", "'d001'").
+				Where("e.emp_no > ?", 1001).(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`INNER JOIN dept_emp AS d ON e.emp_no = d.emp_no ` +
+				`AND (d.to_date > NOW()) AND (d.dept_no = 'd001') WHERE e.emp_no > 1001`,
+		},
+		{
+			gsorm.Select(nil, "e.emp_no", "d.dept_no").
+				From("employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				OrOn("e.emp_no = d.emp_no + 1").
+				LeftJoin("salaries AS s").
+				On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
+			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
+				`INNER JOIN dept_emp AS d ON e.emp_no = d.emp_no OR (e.emp_no = d.emp_no + 1) ` +
+				`LEFT JOIN salaries AS s ON e.emp_no = s.emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_AndOn_NotCallable(t *testing.T) {
+	// AndOn and OrOn are callable only after ON or USING clause.
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf((*iselect.From)(nil)).Elem(),
+		reflect.TypeOf((*iselect.Join)(nil)).Elem(),
+	} {
+		for _, name := range []string{"AndOn", "OrOn"} {
+			if _, ok := typ.MethodByName(name); ok {
+				t.Errorf("%s must not have %s", typ.String(), name)
+			}
+		}
+	}
+}
+
+func TestSelectStmt_Where(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = 1001").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("first_name = ?", "Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name = 'Taro'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE birth_date = '2006-01-02 00:00:00'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name LIKE '%Taro'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no BETWEEN 1001 AND 1003`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no IN (1001, 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no IN (1001, 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no IN (SELECT emp_no FROM dept_manager)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_And(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no = 1002").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no = ?", 1002).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (first_name = 'Taro' OR first_name = 'Jiro')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no = ?", 1002).
+				And("emp_no = ?", 1003).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002) AND (emp_no = 1003)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (birth_date = '2006-01-02 00:00:00')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (first_name LIKE '%Taro')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no BETWEEN 1001 AND 1003)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (1001, 1002))`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (1001, 1002))`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				And("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (SELECT emp_no FROM dept_manager))`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Or(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = 1002").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ? AND first_name = ?", 1002, "Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002 AND first_name = 'Taro')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).
+				Or("emp_no = ?", 1003).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002) OR (emp_no = 1003)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (birth_date = '2006-01-02 00:00:00')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (first_name LIKE '%Taro')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no BETWEEN 1001 AND 1003)`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (1001, 1002))`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (1001, 1002))`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (SELECT emp_no FROM dept_manager))`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_WhereModel(t *testing.T) {
+	type Status string
+	type EmployeeFilter struct {
+		EmpNo     []int  `gsorm:"emp_no"`
+		FirstName string `json:"first_name,omitempty"`
+		LastName  *string
+		Gender    Status
+		Age       int
+		Ignored   string `gsorm:"-"`
+		note      string
+	}
+	lastName := "O'Brien"
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				WhereModel(&EmployeeFilter{FirstName: "Taro", Gender: "M", Ignored: "x", note: "x"}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name = 'Taro' AND gender = 'M'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				WhereModel(EmployeeFilter{EmpNo: []int{1001, 1002}, LastName: &lastName}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no IN (1001, 1002) AND last_name = 'O''Brien'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				WhereModel(&EmployeeFilter{FirstName: "Taro"}, "age", "last_name").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name = 'Taro' AND last_name IS NULL AND age = 0`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("hire_date > ?", "2006-01-02").
+				WhereModel(&EmployeeFilter{EmpNo: []int{}, FirstName: "Taro"}).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE hire_date > '2006-01-02' AND (first_name = 'Taro')`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				WhereModel(&EmployeeFilter{}).
+				And("emp_no > ?", 1001).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no > 1001`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_WhereModel_Fail(t *testing.T) {
+	model := map[string]interface{}{"first_name": "Taro"}
+	_, err := gsorm.Select(nil).From("employees").WhereModel(&model).(*gsorm.SelectStmt).Exists()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "map is invalid type for WhereModel", err.Error())
+}
+
+func TestSelectStmt_Scopes(t *testing.T) {
+	active := func(w iselect.Scope) iselect.Scope {
+		return w.Where("to_date = ?", "9999-01-01")
+	}
+	salaryOver := func(salary int) func(iselect.Scope) iselect.Scope {
+		return func(w iselect.Scope) iselect.Scope {
+			return w.Where("salary > ?", salary)
+		}
+	}
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("salaries").
+				Scopes(active, salaryOver(60000)).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE to_date = '9999-01-01' AND (salary > 60000)`,
+		},
+		{
+			gsorm.Select(nil).From("salaries").
+				Where("emp_no = ?", 1001).
+				Scopes(active).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE emp_no = 1001 AND (to_date = '9999-01-01')`,
+		},
+		{
+			gsorm.Select(nil).From("salaries").
+				Scopes().
+				And("emp_no = ?", 1001).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.Select(nil).From("salaries").
+				Scopes(active).
+				Or("emp_no = ?", 1001).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE to_date = '9999-01-01' OR (emp_no = 1001)`,
+		},
+		{
+			gsorm.Select(nil).From("salaries").
+				RawClause("WHERE emp_no = ?", 1001).
+				And("salary > ?", 60000).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE emp_no = 1001 AND (salary > 60000)`,
+		},
+		{
+			gsorm.Select(nil).From("salaries").
+				Scopes(func(w iselect.Scope) iselect.Scope {
+					return w.WhereModel(&struct{ EmpNo int }{EmpNo: 1001}).Where("salary > ?", 60000)
+				}).(*gsorm.SelectStmt),
+			`SELECT * FROM salaries WHERE emp_no = 1001 AND (salary > 60000)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+
+	// WHERE clause cannot be called after WHERE clause except in the scopes.
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf((*iselect.Where)(nil)).Elem(),
+		reflect.TypeOf((*iupdate.Where)(nil)).Elem(),
+		reflect.TypeOf((*idelete.Where)(nil)).Elem(),
+	} {
+		_, ok := typ.MethodByName("Where")
+		assert.Assert(t, !ok, typ.String())
+	}
+}
+
+func TestSelectStmt_When(t *testing.T) {
+	filter := func(firstName string) func(iselect.Scope) iselect.Scope {
+		return func(w iselect.Scope) iselect.Scope {
+			return w.Where("first_name = ?", firstName)
+		}
+	}
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				When(true, filter("Taro")).
+				When(false, filter("Jiro")).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name = 'Taro'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				When(false, filter("Taro")).(*gsorm.SelectStmt),
+			`SELECT * FROM employees`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Where("emp_no > ?", 1000).
+				When(true, filter("Taro")).
+				OrderBy("emp_no").(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no > 1000 AND (first_name = 'Taro') ORDER BY emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_GroupBy(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries GROUP BY emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Having(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").
+				Having("AVG(salary) > 130000").(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`GROUP BY emp_no ` +
+				`HAVING AVG(salary) > 130000`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").
+				Having("AVG(salary) > ?", 130000).(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`GROUP BY emp_no ` +
+				`HAVING AVG(salary) > 130000`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Having("first_name = ?", "Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees HAVING first_name = 'Taro'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Having("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
+			`SELECT * FROM employees HAVING birth_date = '2006-01-02 00:00:00'`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				Having("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
+			`SELECT * FROM employees HAVING first_name LIKE '%Taro'`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").
+				Having("AVG(salary) BETWEEN ? AND ?", 100000, 130000).(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`GROUP BY emp_no ` +
+				`HAVING AVG(salary) BETWEEN 100000 AND 130000`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").
+				Having("AVG(salary) IN (?)", []int{100000, 130000}).(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`GROUP BY emp_no ` +
+				`HAVING AVG(salary) IN (100000, 130000)`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				GroupBy("emp_no").
+				Having("AVG(salary) IN (?)", [2]int{100000, 130000}).(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`GROUP BY emp_no ` +
+				`HAVING AVG(salary) IN (100000, 130000)`,
+		},
+		{
+			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
+				Having("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			`SELECT emp_no, AVG(salary) FROM salaries ` +
+				`HAVING emp_no IN (SELECT emp_no FROM dept_manager)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Union(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").From("dept_manager").
+				Union(gsorm.Select(nil, "emp_no", "dept_no").From("dept_emp")).(*gsorm.SelectStmt),
+			`(SELECT emp_no, dept_no FROM dept_manager) ` +
+				`UNION (SELECT emp_no, dept_no FROM dept_emp)`,
+		},
+		{
+			gsorm.Select(nil, "emp_no").From("dept_manager").
+				Union(gsorm.Select(nil, "emp_no").From("dept_emp").OrderBy("from_date").Limit(5)).
+				OrderBy("emp_no").
+				Limit(10).(*gsorm.SelectStmt),
+			`(SELECT emp_no FROM dept_manager) ` +
+				`UNION (SELECT emp_no FROM dept_emp ORDER BY from_date LIMIT 5) ` +
+				`ORDER BY emp_no LIMIT 10`,
+		},
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.SQLite)), "emp_no").From("dept_manager").
+				Union(gsorm.Select(nil, "emp_no").From("dept_emp")).
+				Union(gsorm.Select(nil, "emp_no").From("dept_emp").OrderBy("from_date").Limit(5)).
+				OrderBy("emp_no").(*gsorm.SelectStmt),
+			`SELECT emp_no FROM dept_manager ` +
+				`UNION SELECT emp_no FROM dept_emp ` +
+				`UNION SELECT * FROM (SELECT emp_no FROM dept_emp ORDER BY from_date LIMIT 5) ` +
+				`ORDER BY emp_no`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_UnionAll(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no", "dept_no").From("dept_manager").
+				UnionAll(gsorm.Select(nil, "emp_no", "dept_no").From("dept_emp")).(*gsorm.SelectStmt),
+			`(SELECT emp_no, dept_no FROM dept_manager) ` +
+				`UNION ALL (SELECT emp_no, dept_no FROM dept_emp)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Intersect(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no").From("dept_manager").
+				Intersect(gsorm.Select(nil, "emp_no").From("dept_emp")).(*gsorm.SelectStmt),
+			`(SELECT emp_no FROM dept_manager) ` +
+				`INTERSECT (SELECT emp_no FROM dept_emp)`,
+		},
+		{
+			gsorm.Select(nil, "emp_no").From("dept_manager").
+				IntersectAll(gsorm.Select(nil, "emp_no").From("dept_emp")).
+				OrderBy("emp_no").(*gsorm.SelectStmt),
+			`(SELECT emp_no FROM dept_manager) ` +
+				`INTERSECT ALL (SELECT emp_no FROM dept_emp) ` +
+				`ORDER BY emp_no`,
+		},
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.SQLite)), "emp_no").From("dept_manager").
+				Intersect(gsorm.Select(nil, "emp_no").From("dept_emp")).(*gsorm.SelectStmt),
+			`SELECT emp_no FROM dept_manager ` +
+				`INTERSECT SELECT emp_no FROM dept_emp`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Except(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil, "emp_no").From("employees").
+				Except(gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			`(SELECT emp_no FROM employees) ` +
+				`EXCEPT (SELECT emp_no FROM dept_manager)`,
+		},
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL)), "emp_no").From("employees").
+				ExceptAll(gsorm.Select(nil, "emp_no").From("dept_manager")).
+				OrderBy("emp_no").
+				Limit(10).(*gsorm.SelectStmt),
+			`(SELECT emp_no FROM employees) ` +
+				`EXCEPT ALL (SELECT emp_no FROM dept_manager) ` +
+				`ORDER BY emp_no LIMIT 10`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Except_Fail(t *testing.T) {
+	testCases := []struct {
+		Stmt          *gsorm.SelectStmt
+		ExpectedError string
+	}{
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.SQLite)), "emp_no").From("employees").
+				ExceptAll(gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			"EXCEPT ALL is not supported by sqlite3",
+		},
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.MySQL5)), "emp_no").From("employees").
+				Except(gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
+			"EXCEPT is not supported by mysql5",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
+
+func TestSelectStmt_OrderBy(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("birth_date").(*gsorm.SelectStmt),
+			`SELECT * FROM employees ORDER BY birth_date`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("birth_date DESC").(*gsorm.SelectStmt),
+			`SELECT * FROM employees ORDER BY birth_date DESC`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("birth_date desc").(*gsorm.SelectStmt),
+			`SELECT * FROM employees ORDER BY birth_date desc`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("birth_date").
+				OrderBy("hire_date DESC").(*gsorm.SelectStmt),
+			`SELECT * FROM employees ORDER BY birth_date ORDER BY hire_date DESC`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Limit(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM employees LIMIT 10`,
+		},
+		{
+			gsorm.Select(nil).From("employees").Limit(10).Offset(5).(*gsorm.SelectStmt),
+			`SELECT * FROM employees LIMIT 10 OFFSET 5`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_SeekAfter(t *testing.T) {
+	cursor1, _ := gsorm.EncodeCursor(1001)
+	cursor2, _ := gsorm.EncodeCursor("Yamada", 1001)
+	cursor3, _ := gsorm.EncodeCursor("O'Brien")
+
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("emp_no").
+				SeekAfter("").
+				Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM employees ORDER BY emp_no LIMIT 10`,
+		},
+		{
+			gsorm.Select(nil).From("employees").
+				OrderBy("emp_no").
+				SeekAfter(cursor1).
+				Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no > 1001 ORDER BY emp_no LIMIT 10`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Where("id = ?", 10).
-				RawClause("RAW").
-				And("name = ?", "Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`WHERE id = 10 RAW AND (name = 'Taro')`,
+			gsorm.Select(nil).From("employees").
+				OrderBy("emp_no DESC").
+				SeekAfter(cursor1).
+				Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE emp_no < 1001 ORDER BY emp_no DESC LIMIT 10`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Where("id = ?", 10).
-				RawClause("RAW").
-				Or("name = ?", "Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`WHERE id = 10 RAW OR (name = 'Taro')`,
+			gsorm.Select(nil).From("employees").
+				Where("first_name = ?", "Taro").
+				OrderBy("last_name", "emp_no DESC").
+				SeekAfter(cursor2).
+				Limit(10).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE first_name = 'Taro' ` +
+				`AND (last_name > 'Yamada' OR (last_name = 'Yamada' AND emp_no < 1001)) ` +
+				`ORDER BY last_name, emp_no DESC LIMIT 10`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Where("id = ?", 10).
-				And("name = ?", "Taro").
-				RawClause("RAW").
-				GroupBy("column").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`WHERE id = 10 AND (name = 'Taro') ` +
-				`RAW GROUP BY column`,
+			gsorm.Select(nil).From("employees").
+				OrderBy("last_name").
+				SeekAfter(cursor3).(*gsorm.SelectStmt),
+			`SELECT * FROM employees WHERE last_name > 'O''Brien' ORDER BY last_name`,
 		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_SeekAfter_Fail(t *testing.T) {
+	cursor, _ := gsorm.EncodeCursor(1001, "Taro")
+
+	testCases := []struct {
+		Stmt          *gsorm.SelectStmt
+		ExpectedError string
+	}{
 		{
-			gsorm.Select(nil).
-				From("table").
-				Where("id = ?", 10).
-				Or("name = ?", "Taro").
-				RawClause("RAW").
-				GroupBy("column").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`WHERE id = 10 OR (name = 'Taro') ` +
-				`RAW GROUP BY column`,
+			gsorm.Select(nil).From("employees").
+				OrderBy("emp_no").
+				SeekAfter("invalid cursor").(*gsorm.SelectStmt),
+			"cursor is invalid",
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				GroupBy("column").
-				RawClause("RAW").
-				Having("SUM(id) = ?", 10).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`GROUP BY column ` +
-				`RAW HAVING SUM(id) = 10`,
+			gsorm.Select(nil).From("employees").
+				OrderBy("emp_no").
+				SeekAfter(cursor).(*gsorm.SelectStmt),
+			"cursor has 2 values but ORDER BY has 1 columns",
 		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
+
+func TestSelectStmt_Paginate(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+	}
+	expectedReturn := []Employee{{EmpNo: 1011, FirstName: "Taro"}, {EmpNo: 1012, FirstName: "Jiro"}}
+
+	testCases := []struct {
+		Stmt          func(gsorm.MockDB) iselect.PaginateCallable
+		ExpectedItems interfaces.Stmt
+		ExpectedCount interfaces.Stmt
+	}{
 		{
-			gsorm.Select(nil).
-				From("table").
-				Having("SUM(id) = ?", 10).
-				RawClause("RAW").
-				Union(gsorm.Select(nil).From("table2")).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`HAVING SUM(id) = 10 ` +
-				`RAW UNION (SELECT * FROM table2)`,
+			func(mock gsorm.MockDB) iselect.PaginateCallable {
+				return gsorm.Select(mock, "emp_no", "first_name").From("employees").
+					Where("emp_no > ?", 1000).
+					OrderBy("emp_no")
+			},
+			gsorm.Select(nil, "emp_no", "first_name").From("employees").
+				Where("emp_no > ?", 1000).
+				OrderBy("emp_no").
+				Limit(10).
+				Offset(10),
+			gsorm.Select(nil, "COUNT(*)").From("employees").
+				Where("emp_no > ?", 1000),
+		},
+		{
+			func(mock gsorm.MockDB) iselect.PaginateCallable {
+				return gsorm.Select(mock, "emp_no", "first_name").From("salaries").
+					GroupBy("emp_no", "first_name").
+					OrderBy("emp_no")
+			},
+			gsorm.Select(nil, "emp_no", "first_name").From("salaries").
+				GroupBy("emp_no", "first_name").
+				OrderBy("emp_no").
+				Limit(10).
+				Offset(10),
+			gsorm.Select(nil, "COUNT(*)").
+				RawClause("FROM (SELECT emp_no, first_name FROM salaries GROUP BY emp_no, first_name) AS paginated"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		mock := gsorm.OpenMock()
+		mock.ExpectWithReturn(testCase.ExpectedItems, expectedReturn)
+		mock.ExpectWithReturn(testCase.ExpectedCount, int64(12))
+
+		model := []Employee{}
+		total, err := testCase.Stmt(mock).Paginate(&model, 2, 10)
+		if err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			continue
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, int64(12), total)
+		if diff := cmp.Diff(expectedReturn, model); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestSelectStmt_Paginate_Fail(t *testing.T) {
+	model := []int{}
+	_, err := gsorm.Select(gsorm.OpenMock()).From("employees").Paginate(&model, 0, 10)
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "page and size must be greater than 0", err.Error())
+}
+
+func TestSelectStmt_First(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+	}
+	ct := []gsorm.ExportedIColumnType{
+		newFakeColumn("emp_no", reflect.TypeOf(0)),
+		newFakeColumn("first_name", reflect.TypeOf("")),
+	}
+
+	// The row is selected.
+	model := Employee{}
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001, "Taro"}}))
+	if err := gsorm.Select(db).From("employees").OrderBy("emp_no").First(&model); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, Employee{EmpNo: 1001, FirstName: "Taro"}, model)
+
+	// No row is selected.
+	db = newFakeDB(newFakeRows(ct, [][]interface{}{}))
+	err := gsorm.Select(db).From("employees").First(&model)
+	assert.Equal(t, gsorm.ErrNoRows, err)
+}
+
+func TestSelectStmt_FirstWithMock(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+	}
+	expected := Employee{EmpNo: 1001, FirstName: "Taro"}
+
+	testCases := []struct {
+		Returned      interface{}
+		ExpectedError error
+	}{
+		{expected, nil},
+		{[]Employee{expected, {EmpNo: 1002, FirstName: "Jiro"}}, nil},
+		{[]Employee{}, gsorm.ErrNoRows},
+		{nil, gsorm.ErrNoRows},
+	}
+
+	for _, testCase := range testCases {
+		mock := gsorm.OpenMock()
+		mock.ExpectWithReturn(gsorm.Select(nil).From("employees").Limit(1), testCase.Returned)
+
+		model := Employee{}
+		err := gsorm.Select(mock).From("employees").First(&model)
+		if testCase.ExpectedError != nil {
+			assert.Equal(t, testCase.ExpectedError, err)
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			continue
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, expected, model)
+	}
+}
+
+func TestSelectStmt_FirstWithMock_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo int
+	}
+
+	// The statement without LIMIT 1 is not same as the executed statement.
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil).From("employees"), Employee{EmpNo: 1001})
+	err := gsorm.Select(mock).From("employees").First(&Employee{})
+	if err == nil {
+		t.Errorf("Error was not occurred")
+	}
+}
+
+func TestSelectStmt_Exists(t *testing.T) {
+	ct := []gsorm.ExportedIColumnType{newFakeColumn("exists", reflect.TypeOf(int64(0)))}
+
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{int64(1)}}))
+	exists, err := gsorm.Select(db).From("employees").Where("emp_no = ?", 1001).Exists()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, true, exists)
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil).From("employees").Where("emp_no = ?", 1001), false)
+	exists, err = gsorm.Select(mock).From("employees").Where("emp_no = ?", 1001).Exists()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, false, exists)
+}
+
+func TestSelectStmt_Pluck(t *testing.T) {
+	ct := []gsorm.ExportedIColumnType{newFakeColumn("emp_no", reflect.TypeOf(0))}
+
+	model := []int{}
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{{1001}, {1002}})).(*fakeDB)
+	if err := gsorm.Select(db, "first_name").From("employees").Pluck("emp_no", &model); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []int{1001, 1002}, model)
+	assert.DeepEqual(t, []string{"SELECT emp_no FROM employees"}, db.queries)
+
+	// The statement which selects only the column is compared with expected.
+	model = []int{}
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil, "emp_no").From("employees"), []int{1003})
+	if err := gsorm.Select(mock, "first_name").From("employees").Pluck("emp_no", &model); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.DeepEqual(t, []int{1003}, model)
+
+	mock = gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Select(nil).From("employees"), []int{1003})
+	err := gsorm.Select(mock).From("employees").Pluck("emp_no", &model)
+	assert.ErrorContains(t, err, "statements comparison was failed")
+}
+
+func TestSelectStmt_Scalar(t *testing.T) {
+	newDB := func(v interface{}) gsorm.DB {
+		ct := []gsorm.ExportedIColumnType{newFakeColumn("value", reflect.TypeOf(v))}
+		return newFakeDB(newFakeRows(ct, [][]interface{}{{v}}))
+	}
+
+	i, err := gsorm.Count(newDB(int64(10))).From("employees").Int64()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, int64(10), i)
+
+	f, err := gsorm.Avg(newDB([]byte("60000.5"))).From("salaries").Float64()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, 60000.5, f)
+
+	s, err := gsorm.Max(newDB([]byte("Yamada"))).From("employees").StringValue()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, "Yamada", s)
+
+	// NULL is returned as zero value.
+	i, err = gsorm.Sum(newDB(nil), "salary").From("salaries").Int64()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, int64(0), i)
+
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Count(nil).From("employees"), 300024)
+	i, err = gsorm.Count(mock).From("employees").Int64()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	if err := mock.Complete(); err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+	}
+	assert.Equal(t, int64(300024), i)
+}
+
+func TestSelectStmt_Scalar_Fail(t *testing.T) {
+	mock := gsorm.OpenMock()
+	mock.ExpectWithReturn(gsorm.Count(nil).From("employees"), "ten")
+	_, err := gsorm.Count(mock).From("employees").Int64()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+	}
+
+	ct := []gsorm.ExportedIColumnType{newFakeColumn("value", reflect.TypeOf(int64(0)))}
+	db := newFakeDB(newFakeRows(ct, [][]interface{}{}))
+	_, err = gsorm.Count(db).From("employees").Int64()
+	assert.Equal(t, gsorm.ErrNoRows, err)
+}
+
+func TestSelectStmt_Expr(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.SelectStmt
+		Expected string
+	}{
+		{
+			gsorm.SelectExpr(nil, "emp_no", expr.Coalesce("nickname", expr.Value("none")).As("name")).
+				From("employees").(*gsorm.SelectStmt),
+			`SELECT emp_no, COALESCE(nickname, 'none') AS name FROM employees`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Having("SUM(id) = ?", 10).
-				RawClause("RAW").
-				UnionAll(gsorm.Select(nil).From("table2")).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`HAVING SUM(id) = 10 ` +
-				`RAW UNION ALL (SELECT * FROM table2)`,
+			gsorm.SelectExpr(nil, "emp_no", expr.Sum("salary").As("total")).
+				From("salaries").
+				GroupBy("emp_no").
+				Having("? > ?", expr.Sum("salary"), 1000000).
+				OrderByExpr(expr.Sum("salary")).(*gsorm.SelectStmt),
+			`SELECT emp_no, SUM(salary) AS total FROM salaries ` +
+				`GROUP BY emp_no HAVING SUM(salary) > 1000000 ORDER BY SUM(salary)`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Union(gsorm.Select(nil).From("table2")).
-				RawClause("RAW").
-				OrderBy("id").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`UNION (SELECT * FROM table2) ` +
-				`RAW ORDER BY id`,
+			gsorm.SelectExpr(nil, expr.Fn("YEAR", "hire_date").As("year"), expr.Count()).
+				From("employees").
+				GroupByExpr(expr.Fn("YEAR", "hire_date")).(*gsorm.SelectStmt),
+			`SELECT YEAR(hire_date) AS year, COUNT(*) FROM employees GROUP BY YEAR(hire_date)`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				UnionAll(gsorm.Select(nil).From("table2")).
-				RawClause("RAW").
-				OrderBy("id").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`UNION ALL (SELECT * FROM table2) ` +
-				`RAW ORDER BY id`,
+			gsorm.Select(nil, "emp_no").
+				From("employees").
+				OrderByExpr(expr.Case().When("gender = 'F'", 0).Else(1), "emp_no").(*gsorm.SelectStmt),
+			`SELECT emp_no FROM employees ORDER BY CASE WHEN gender = 'F' THEN 0 ELSE 1 END, emp_no`,
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				OrderBy("id").
-				RawClause("RAW").
-				Limit(10).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`ORDER BY id ` +
-				`RAW LIMIT 10`,
+			gsorm.Select(nil, "emp_no").
+				From("salaries").
+				Where("? > ?", expr.Mul("salary", 1.1), 60000).(*gsorm.SelectStmt),
+			`SELECT emp_no FROM salaries WHERE (salary * 1.1) > 60000`,
 		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestSelectStmt_Expr_Fail(t *testing.T) {
+	testCases := []struct {
+		Stmt          *gsorm.SelectStmt
+		ExpectedError string
+	}{
 		{
-			gsorm.Select(nil).
-				From("table").
-				Limit(10).
-				RawClause("RAW").
-				Offset(5).(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`LIMIT 10 RAW OFFSET 5`,
+			gsorm.SelectExpr(nil, 10).From("employees").(*gsorm.SelectStmt),
+			"int is invalid type for column",
 		},
 		{
-			gsorm.Select(nil).
-				From("table").
-				Limit(10).
-				Offset(5).
-				RawClause("RAW").(*gsorm.SelectStmt),
-			`SELECT * FROM table ` +
-				`LIMIT 10 OFFSET 5 RAW`,
+			gsorm.Select(nil).From("employees").
+				OrderByExpr(expr.Raw("FIELD(emp_no, ?)")).(*gsorm.SelectStmt),
+			"number of values doesn't match the number of '?'",
 		},
 	}
 
 	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
 			continue
 		}
-		assert.Equal(t, testCase.Expected, actual)
+		assert.Equal(t, testCase.ExpectedError, err.Error())
 	}
 }
 
-func TestSelectStmt_From(t *testing.T) {
+func TestSelectStmt_ForUpdate(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.SelectStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil, "emp_no").From("employees").(*gsorm.SelectStmt),
-			`SELECT emp_no FROM employees`,
+			gsorm.Select(nil).From("jobs").
+				Where("id = ?", 1).
+				ForUpdate().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE id = 1 FOR UPDATE`,
 		},
 		{
-			gsorm.Select(nil, "emp_no").From("employees AS e").(*gsorm.SelectStmt),
-			`SELECT emp_no FROM employees AS e`,
+			gsorm.Select(nil).From("jobs").
+				Where("status = ?", "queued").
+				OrderBy("id").
+				Limit(10).
+				ForUpdate().
+				SkipLocked().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE status = 'queued' ORDER BY id LIMIT 10 FOR UPDATE SKIP LOCKED`,
 		},
 		{
-			gsorm.Select(nil, "emp_no").From("employees as e").(*gsorm.SelectStmt),
-			`SELECT emp_no FROM employees AS e`,
+			gsorm.Select(nil, "j.id").From("jobs AS j").
+				Join("workers AS w").On("j.worker_id = w.id").
+				Where("w.id = ?", 1).
+				ForUpdate().
+				Of("j").
+				NoWait().(*gsorm.SelectStmt),
+			`SELECT j.id FROM jobs AS j INNER JOIN workers AS w ON j.worker_id = w.id ` +
+				`WHERE w.id = 1 FOR UPDATE OF j NOWAIT`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "dept_no").From("employees", "departments").(*gsorm.SelectStmt),
-			`SELECT emp_no, dept_no FROM employees, departments`,
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.MySQL5))).From("jobs").
+				Where("id = ?", 1).
+				ForUpdate().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE id = 1 FOR UPDATE`,
 		},
 	}
 
@@ -720,27 +3381,29 @@ func TestSelectStmt_From(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_Join(t *testing.T) {
+func TestSelectStmt_ForShare(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.SelectStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				Join("dept_manager AS d").
-				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`INNER JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+			gsorm.Select(nil).From("jobs").
+				Where("id = ?", 1).
+				ForShare().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE id = 1 FOR SHARE`,
 		},
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				Join("dept_manager AS d").On("e.emp_no = d.emp_no").
-				LeftJoin("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`INNER JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
-				`LEFT JOIN salaries AS s ON e.emp_no = s.emp_no`,
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))).From("jobs").
+				Where("id = ?", 1).
+				ForShare().
+				NoWait().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE id = 1 FOR SHARE NOWAIT`,
+		},
+		{
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.MySQL5))).From("jobs").
+				Where("id = ?", 1).
+				ForShare().(*gsorm.SelectStmt),
+			`SELECT * FROM jobs WHERE id = 1 LOCK IN SHARE MODE`,
 		},
 	}
 
@@ -754,61 +3417,110 @@ func TestSelectStmt_Join(t *testing.T) {
 		assert.Equal(t, testCase.Expected, actual)
 	}
 }
-func TestSelectStmt_LeftJoin(t *testing.T) {
+
+func TestSelectStmt_ForUpdate_Fail(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
-		Expected string
+		Stmt          *gsorm.SelectStmt
+		ExpectedError string
 	}{
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				LeftJoin("dept_manager AS d").
-				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`LEFT JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.MySQL5))).From("jobs").
+				ForUpdate().
+				SkipLocked().(*gsorm.SelectStmt),
+			"SKIP LOCKED is not supported by mysql5",
 		},
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				LeftJoin("dept_manager AS d").On("e.emp_no = d.emp_no").
-				RightJoin("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`LEFT JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
-				`RIGHT JOIN salaries AS s ON e.emp_no = s.emp_no`,
+			gsorm.Select(gsorm.OpenMock(gsorm.WithDialect(gsorm.SQLite))).From("jobs").
+				ForUpdate().(*gsorm.SelectStmt),
+			"FOR UPDATE is not supported by sqlite3",
 		},
 	}
 
 	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
 			continue
 		}
-		assert.Equal(t, testCase.Expected, actual)
+		assert.Equal(t, testCase.ExpectedError, err.Error())
 	}
 }
-func TestSelectStmt_RightJoin(t *testing.T) {
+
+func TestSelectStmt_ForUpdate_NotCallable(t *testing.T) {
+	// Of, NoWait and SkipLocked are callable only after the row locking clause.
+	typ := reflect.TypeOf((*iselect.RawClause)(nil)).Elem()
+	for _, name := range []string{"Of", "NoWait", "SkipLocked"} {
+		if _, ok := typ.MethodByName(name); ok {
+			t.Errorf("%s must not have %s", typ.String(), name)
+		}
+	}
+}
+
+func TestUpdateStmt_RawClause(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				RightJoin("dept_manager AS d").
-				On("e.emp_no = d.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`RIGHT JOIN dept_manager AS d ON e.emp_no = d.emp_no`,
+			gsorm.Update(nil, "table").
+				RawClause("RAW").
+				Set("column", "value").(*gsorm.UpdateStmt),
+			`UPDATE table RAW SET column = 'value'`,
 		},
 		{
-			gsorm.Select(nil, "e.emp_no", "d.dept_no").
-				From("employees AS e").
-				RightJoin("dept_manager AS d").On("e.emp_no = d.emp_no").
-				Join("salaries AS s").On("e.emp_no = s.emp_no").(*gsorm.SelectStmt),
-			`SELECT e.emp_no, d.dept_no FROM employees AS e ` +
-				`RIGHT JOIN dept_manager AS d ON e.emp_no = d.emp_no ` +
-				`INNER JOIN salaries AS s ON e.emp_no = s.emp_no`,
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				RawClause("RAW").
+				Set("column2", "value2").(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' RAW, column2 = 'value2'`,
+		},
+		{
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				RawClause("RAW").
+				Where("column = ?", 10).(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' ` +
+				`RAW WHERE column = 10`,
+		},
+		{
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				Where("column = ?", 10).
+				RawClause("RAW").
+				And("column = ?", 100).(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' ` +
+				`WHERE column = 10 ` +
+				`RAW AND (column = 100)`,
+		},
+		{
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				Where("column = ?", 10).
+				RawClause("RAW").
+				Or("column = ?", 100).(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' ` +
+				`WHERE column = 10 ` +
+				`RAW OR (column = 100)`,
+		},
+		{
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				Where("column = ?", 10).
+				And("column = ?", 100).
+				RawClause("RAW").(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' ` +
+				`WHERE column = 10 ` +
+				`AND (column = 100) RAW`,
+		},
+		{
+			gsorm.Update(nil, "table").
+				Set("column", "value").
+				Where("column = ?", 10).
+				Or("column = ?", 100).
+				RawClause("RAW").(*gsorm.UpdateStmt),
+			`UPDATE table SET column = 'value' ` +
+				`WHERE column = 10 ` +
+				`OR (column = 100) RAW`,
 		},
 	}
 
@@ -822,55 +3534,28 @@ func TestSelectStmt_RightJoin(t *testing.T) {
 		assert.Equal(t, testCase.Expected, actual)
 	}
 }
-func TestSelectStmt_Where(t *testing.T) {
+
+func TestUpdateStmt_Set(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = 1001").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("first_name = ?", "Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE first_name = 'Taro'`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE birth_date = '2006-01-02 00:00:00'`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE first_name LIKE '%Taro'`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no BETWEEN 1001 AND 1003`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no IN (1001, 1002)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no IN (1001, 1002)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Set("last_name", "Suzuki").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako', last_name = 'Suzuki'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no IN (SELECT emp_no FROM dept_manager)`,
+			gsorm.Update(nil, "salaries").
+				Set("salary", expr.Mul("salary", 1.1)).
+				Set("to_date", expr.Fn("NOW")).(*gsorm.UpdateStmt),
+			`UPDATE salaries SET salary = (salary * 1.1), to_date = NOW()`,
 		},
 	}
 
@@ -885,71 +3570,73 @@ func TestSelectStmt_Where(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_And(t *testing.T) {
+func TestUpdateStmt_Where(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no = 1002").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002)`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no = ?", 1002).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = 1001").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (first_name = 'Taro' OR first_name = 'Jiro')`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no = ?", 1002).
-				And("emp_no = ?", 1003).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no = 1002) AND (emp_no = 1003)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("first_name = ?", "Taro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE first_name = 'Taro'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (birth_date = '2006-01-02 00:00:00')`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE birth_date = '2006-01-02 00:00:00'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (first_name LIKE '%Taro')`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE first_name LIKE '%Taro'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no BETWEEN 1001 AND 1003)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no BETWEEN 1001 AND 1003`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (1001, 1002))`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no IN (1001, 1002)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (1001, 1002))`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no IN (1001, 1002)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 AND (emp_no IN (SELECT emp_no FROM dept_manager))`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no IN (SELECT emp_no FROM dept_manager)`,
 		},
 	}
 
@@ -964,71 +3651,102 @@ func TestSelectStmt_And(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_Or(t *testing.T) {
+func TestUpdateStmt_And(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no = 1002").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002)`,
+				And("emp_no = 1002").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no = 1002)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no = ?", 1002).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002)`,
+				And("emp_no = ?", 1002).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no = 1002)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no = ? AND first_name = ?", 1002, "Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002 AND first_name = 'Taro')`,
+				And("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (first_name = 'Taro' OR first_name = 'Jiro')`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no = ?", 1002).
-				Or("emp_no = ?", 1003).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no = 1002) OR (emp_no = 1003)`,
+				And("emp_no = ?", 1002).
+				And("emp_no = ?", 1003).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no = 1002) ` +
+				`AND (emp_no = 1003)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (birth_date = '2006-01-02 00:00:00')`,
+				And("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (birth_date = '2006-01-02 00:00:00')`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (first_name LIKE '%Taro')`,
+				And("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (first_name LIKE '%Taro')`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no BETWEEN 1001 AND 1003)`,
+				And("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no BETWEEN 1001 AND 1003)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", []int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (1001, 1002))`,
+				And("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no IN (1001, 1002))`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (1001, 1002))`,
+				And("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no IN (1001, 1002))`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
 				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
-			`SELECT * FROM employees WHERE emp_no = 1001 OR (emp_no IN (SELECT emp_no FROM dept_manager))`,
+				And("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`AND (emp_no IN (SELECT emp_no FROM dept_manager))`,
 		},
 	}
 
@@ -1043,94 +3761,102 @@ func TestSelectStmt_Or(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_GroupBy(t *testing.T) {
+func TestUpdateStmt_Or(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries GROUP BY emp_no`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = 1002").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no = 1002)`,
 		},
-	}
-
-	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
-			continue
-		}
-		assert.Equal(t, testCase.Expected, actual)
-	}
-}
-
-func TestSelectStmt_Having(t *testing.T) {
-	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
-		Expected string
-	}{
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").
-				Having("AVG(salary) > 130000").(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`GROUP BY emp_no ` +
-				`HAVING AVG(salary) > 130000`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no = 1002)`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").
-				Having("AVG(salary) > ?", 130000).(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`GROUP BY emp_no ` +
-				`HAVING AVG(salary) > 130000`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (first_name = 'Taro' OR first_name = 'Jiro')`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Having("first_name = ?", "Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees HAVING first_name = 'Taro'`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).
+				Or("emp_no = ?", 1003).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no = 1002) ` +
+				`OR (emp_no = 1003)`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Having("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.SelectStmt),
-			`SELECT * FROM employees HAVING birth_date = '2006-01-02 00:00:00'`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (birth_date = '2006-01-02 00:00:00')`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				Having("first_name LIKE ?", "%Taro").(*gsorm.SelectStmt),
-			`SELECT * FROM employees HAVING first_name LIKE '%Taro'`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (first_name LIKE '%Taro')`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").
-				Having("AVG(salary) BETWEEN ? AND ?", 100000, 130000).(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`GROUP BY emp_no ` +
-				`HAVING AVG(salary) BETWEEN 100000 AND 130000`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no BETWEEN 1001 AND 1003)`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").
-				Having("AVG(salary) IN (?)", []int{100000, 130000}).(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`GROUP BY emp_no ` +
-				`HAVING AVG(salary) IN (100000, 130000)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no IN (1001, 1002))`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				GroupBy("emp_no").
-				Having("AVG(salary) IN (?)", [2]int{100000, 130000}).(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`GROUP BY emp_no ` +
-				`HAVING AVG(salary) IN (100000, 130000)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no IN (1001, 1002))`,
 		},
 		{
-			gsorm.Select(nil, "emp_no", "AVG(salary)").From("salaries").
-				Having("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.SelectStmt),
-			`SELECT emp_no, AVG(salary) FROM salaries ` +
-				`HAVING emp_no IN (SELECT emp_no FROM dept_manager)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Or("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' ` +
+				`WHERE emp_no = 1001 ` +
+				`OR (emp_no IN (SELECT emp_no FROM dept_manager))`,
 		},
 	}
 
@@ -1145,40 +3871,42 @@ func TestSelectStmt_Having(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_Union(t *testing.T) {
-	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
-		Expected string
-	}{
-		{
-			gsorm.Select(nil, "emp_no", "dept_no").From("dept_manager").
-				Union(gsorm.Select(nil, "emp_no", "dept_no").From("dept_emp")).(*gsorm.SelectStmt),
-			`SELECT emp_no, dept_no FROM dept_manager ` +
-				`UNION (SELECT emp_no, dept_no FROM dept_emp)`,
-		},
+func TestUpdateStmt_WhereModel(t *testing.T) {
+	type EmployeeFilter struct {
+		EmpNo    int `gsorm:"emp_no"`
+		LastName string
 	}
 
-	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
-			continue
+	actual := gsorm.Update(nil, "employees").
+		Set("first_name", "Hanako").
+		WhereModel(&EmployeeFilter{EmpNo: 1001, LastName: "Yamada"}).(*gsorm.UpdateStmt).SQL()
+	assert.Equal(t, `UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001 AND last_name = 'Yamada'`, actual)
+}
+
+func TestUpdateStmt_Scopes(t *testing.T) {
+	byEmpNo := func(empNo int) func(iupdate.Scope) iupdate.Scope {
+		return func(w iupdate.Scope) iupdate.Scope {
+			return w.Where("emp_no = ?", empNo)
 		}
-		assert.Equal(t, testCase.Expected, actual)
 	}
-}
 
-func TestSelectStmt_UnionAll(t *testing.T) {
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil, "emp_no", "dept_no").From("dept_manager").
-				UnionAll(gsorm.Select(nil, "emp_no", "dept_no").From("dept_emp")).(*gsorm.SelectStmt),
-			`SELECT emp_no, dept_no FROM dept_manager ` +
-				`UNION ALL (SELECT emp_no, dept_no FROM dept_emp)`,
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Scopes(byEmpNo(1001)).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.Update(nil, "employees").
+				Set("first_name", "Hanako").
+				Where("last_name = ?", "Yamada").
+				When(true, byEmpNo(1001)).
+				When(false, byEmpNo(1002)).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' WHERE last_name = 'Yamada' AND (emp_no = 1001)`,
 		},
 	}
 
@@ -1193,31 +3921,25 @@ func TestSelectStmt_UnionAll(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_OrderBy(t *testing.T) {
+func TestUpdateStmt_Model(t *testing.T) {
+	type Employee struct {
+		ID        int `gsorm:"emp_no"`
+		FirstName string
+	}
+	structModel := Employee{ID: 1001, FirstName: "Taro"}
+	mapModel := map[string]interface{}{"emp_no": 1001, "first_name": "Taro"}
+
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).From("employees").
-				OrderBy("birth_date").(*gsorm.SelectStmt),
-			`SELECT * FROM employees ORDER BY birth_date`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				OrderBy("birth_date DESC").(*gsorm.SelectStmt),
-			`SELECT * FROM employees ORDER BY birth_date DESC`,
-		},
-		{
-			gsorm.Select(nil).From("employees").
-				OrderBy("birth_date desc").(*gsorm.SelectStmt),
-			`SELECT * FROM employees ORDER BY birth_date desc`,
+			gsorm.Update(nil, "employees").Model(&structModel, "emp_no", "first_name").(*gsorm.UpdateStmt),
+			`UPDATE employees SET emp_no = 1001, first_name = 'Taro'`,
 		},
 		{
-			gsorm.Select(nil).From("employees").
-				OrderBy("birth_date").
-				OrderBy("hire_date DESC").(*gsorm.SelectStmt),
-			`SELECT * FROM employees ORDER BY birth_date ORDER BY hire_date DESC`,
+			gsorm.Update(nil, "employees").Model(&mapModel, "emp_no", "first_name").(*gsorm.UpdateStmt),
+			`UPDATE employees SET emp_no = 1001, first_name = 'Taro'`,
 		},
 	}
 
@@ -1232,97 +3954,94 @@ func TestSelectStmt_OrderBy(t *testing.T) {
 	}
 }
 
-func TestSelectStmt_Limit(t *testing.T) {
+func TestUpdateStmt_AutoTime(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+		UpdatedAt time.Time `gsorm:"autoupdatetime"`
+	}
+	now := time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC)
+
 	testCases := []struct {
-		Stmt     *gsorm.SelectStmt
+		Stmt     func(db gsorm.DB, model *Employee) iupdate.Where
+		Model    *Employee
 		Expected string
 	}{
 		{
-			gsorm.Select(nil).From("employees").Limit(10).(*gsorm.SelectStmt),
-			`SELECT * FROM employees LIMIT 10`,
+			func(db gsorm.DB, model *Employee) iupdate.Where {
+				return gsorm.UpdateModel(db, model).Where("emp_no = ?", 1001)
+			},
+			&Employee{EmpNo: 1001, FirstName: "Hanako"},
+			`UPDATE employees SET emp_no = 1001, first_name = 'Hanako', updated_at = '2021-04-01 09:00:00' WHERE emp_no = 1001`,
 		},
 		{
-			gsorm.Select(nil).From("employees").Limit(10).Offset(5).(*gsorm.SelectStmt),
-			`SELECT * FROM employees LIMIT 10 OFFSET 5`,
+			func(db gsorm.DB, model *Employee) iupdate.Where {
+				return gsorm.Update(db, "employees").Model(model, "first_name").Where("emp_no = ?", 1001)
+			},
+			&Employee{FirstName: "Hanako"},
+			`UPDATE employees SET first_name = 'Hanako', updated_at = '2021-04-01 09:00:00' WHERE emp_no = 1001`,
 		},
 	}
 
 	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
+		fdb := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+		db := &gsorm.ExportedClockDB{DB: fdb, Clock: func() time.Time { return now }}
+		s := testCase.Stmt(db, testCase.Model)
+
+		// Building SQL doesn't modify the model.
+		_ = s.(*gsorm.UpdateStmt).SQL()
+		assert.Equal(t, time.Time{}, testCase.Model.UpdatedAt)
+
+		if err := s.Exec(); err != nil {
+			t.Errorf("Error was occurred: %v", err)
 			continue
 		}
-		assert.Equal(t, testCase.Expected, actual)
+		assert.DeepEqual(t, []string{testCase.Expected}, fdb.execs)
+		assert.Equal(t, now, testCase.Model.UpdatedAt)
+		assert.Equal(t, time.Time{}, testCase.Model.CreatedAt)
 	}
 }
 
-func TestUpdateStmt_RawClause(t *testing.T) {
+func TestUpdateStmt_NestedModel(t *testing.T) {
+	type Base struct {
+		EmpNo     int
+		CreatedAt time.Time `gsorm:"autocreatetime"`
+	}
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type Employee struct {
+		Base
+		FirstName string
+		Address   Address `gsorm:"prefix=addr_"`
+		Dept      Department
+	}
+	model := Employee{
+		Base:      Base{EmpNo: 1001},
+		FirstName: "Taro",
+		Address:   Address{City: "Tokyo", Zip: "100-0001"},
+		Dept:      Department{DeptNo: "d001"},
+	}
+
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Update(nil, "table").
-				RawClause("RAW").
-				Set("column", "value").(*gsorm.UpdateStmt),
-			`UPDATE table RAW SET column = 'value'`,
-		},
-		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				RawClause("RAW").
-				Set("column2", "value2").(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' RAW, column2 = 'value2'`,
-		},
-		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				RawClause("RAW").
-				Where("column = ?", 10).(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' ` +
-				`RAW WHERE column = 10`,
-		},
-		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				Where("column = ?", 10).
-				RawClause("RAW").
-				And("column = ?", 100).(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' ` +
-				`WHERE column = 10 ` +
-				`RAW AND (column = 100)`,
-		},
-		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				Where("column = ?", 10).
-				RawClause("RAW").
-				Or("column = ?", 100).(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' ` +
-				`WHERE column = 10 ` +
-				`RAW OR (column = 100)`,
+			gsorm.UpdateModel(nil, &model).Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE employees SET emp_no = 1001, first_name = 'Taro', addr_city = 'Tokyo', addr_zip = '100-0001' ` +
+				`WHERE emp_no = 1001`,
 		},
 		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				Where("column = ?", 10).
-				And("column = ?", 100).
-				RawClause("RAW").(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' ` +
-				`WHERE column = 10 ` +
-				`AND (column = 100) RAW`,
+			gsorm.UpdateModel(nil, &model, "addr_city").Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE employees SET addr_city = 'Tokyo' WHERE emp_no = 1001`,
 		},
 		{
-			gsorm.Update(nil, "table").
-				Set("column", "value").
-				Where("column = ?", 10).
-				Or("column = ?", 100).
-				RawClause("RAW").(*gsorm.UpdateStmt),
-			`UPDATE table SET column = 'value' ` +
-				`WHERE column = 10 ` +
-				`OR (column = 100) RAW`,
+			gsorm.Update(nil, "employees").Set("first_name", "Hanako").
+				WhereModel(&Employee{Base: Base{EmpNo: 1001}, Address: Address{City: "Tokyo"}}).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001 AND addr_city = 'Tokyo'`,
 		},
 	}
 
@@ -1337,21 +4056,35 @@ func TestUpdateStmt_RawClause(t *testing.T) {
 	}
 }
 
-func TestUpdateStmt_Set(t *testing.T) {
+func TestUpdateStmt_Version(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+		Version   int `gsorm:"lock_version,version"`
+	}
+
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
+		{
+			gsorm.UpdateModel(nil, &Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}).
+				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE employees SET emp_no = 1001, first_name = 'Hanako', lock_version = lock_version + 1 ` +
+				`WHERE lock_version = 3 AND (emp_no = 1001)`,
+		},
 		{
 			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako'`,
+				Model(&Employee{FirstName: "Hanako", Version: 3}, "first_name").
+				Where("emp_no = ?", 1001).
+				Or("emp_no = ?", 1002).(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako', lock_version = lock_version + 1 ` +
+				`WHERE lock_version = 3 AND (emp_no = 1001 OR (emp_no = 1002))`,
 		},
 		{
 			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Set("last_name", "Suzuki").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako', last_name = 'Suzuki'`,
+				Model(&Employee{FirstName: "Hanako"}, "first_name").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako', lock_version = lock_version + 1 WHERE lock_version = 0`,
 		},
 	}
 
@@ -1366,73 +4099,142 @@ func TestUpdateStmt_Set(t *testing.T) {
 	}
 }
 
-func TestUpdateStmt_Where(t *testing.T) {
+func TestUpdateStmt_Version_Exec(t *testing.T) {
+	type Employee struct {
+		EmpNo   int
+		Version uint `gsorm:"version"`
+	}
+
+	{
+		model := Employee{EmpNo: 1001, Version: 3}
+		db := newFakeDBWithResult(newFakeResult(0, 1)).(*fakeDB)
+		if err := gsorm.UpdateModel(db, &model).Where("emp_no = ?", 1001).Exec(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, uint(4), model.Version)
+		assert.DeepEqual(t, []string{
+			"UPDATE employees SET emp_no = 1001, version = version + 1 WHERE version = 3 AND (emp_no = 1001)",
+		}, db.execs)
+	}
+	{
+		model := Employee{EmpNo: 1001, Version: 3}
+		db := newFakeDBWithResult(newFakeResult(0, 0))
+		err := gsorm.UpdateModel(db, &model).Where("emp_no = ?", 1001).Exec()
+		assert.Equal(t, gsorm.ErrStaleObject, err)
+		assert.Equal(t, uint(3), model.Version)
+	}
+	{
+		model := Employee{EmpNo: 1001, Version: 3}
+		mock := gsorm.OpenMock()
+		mock.Expect(gsorm.UpdateModel(nil, &Employee{EmpNo: 1001, Version: 3}).Where("emp_no = ?", 1001))
+		if err := gsorm.UpdateModel(mock, &model).Where("emp_no = ?", 1001).Exec(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, uint(4), model.Version)
+	}
+}
+
+func TestUpdateStmt_Version_Query(t *testing.T) {
+	type Employee struct {
+		EmpNo     int
+		FirstName string
+		Version   int `gsorm:"version"`
+	}
+	ct := []gsorm.ExportedIColumnType{newFakeColumn("first_name", reflect.TypeOf(""))}
+	versionCt := []gsorm.ExportedIColumnType{newFakeColumn("version", reflect.TypeOf(0))}
+
+	{
+		model := Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}
+		fdb := newFakeDB(newFakeRows(ct, [][]interface{}{{"Hanako"}})).(*fakeDB)
+		db := &gsorm.ExportedDialectDB{DB: fdb, Dialect: gsorm.PostgreSQL}
+		if err := gsorm.UpdateModel(db, &model, "first_name").Where("emp_no = ?", 1001).
+			Returning("first_name").Query(&model); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, 4, model.Version)
+		assert.DeepEqual(t, []string{
+			"UPDATE employees SET first_name = 'Hanako', version = version + 1 " +
+				"WHERE version = 3 AND (emp_no = 1001) RETURNING first_name",
+		}, fdb.queries)
+	}
+	{
+		// The returned version is not incremented again.
+		model := Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}
+		db := &gsorm.ExportedDialectDB{DB: newFakeDB(newFakeRows(versionCt, [][]interface{}{{4}})), Dialect: gsorm.PostgreSQL}
+		if err := gsorm.UpdateModel(db, &model, "first_name").Where("emp_no = ?", 1001).
+			Returning("version").Query(&model); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, 4, model.Version)
+	}
+	{
+		model := Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}
+		db := &gsorm.ExportedDialectDB{DB: newFakeDB(newFakeRows(ct, [][]interface{}{})), Dialect: gsorm.PostgreSQL}
+		err := gsorm.UpdateModel(db, &model, "first_name").Where("emp_no = ?", 1001).
+			Returning("first_name").Query(&model)
+		assert.Equal(t, gsorm.ErrStaleObject, err)
+		assert.Equal(t, 3, model.Version)
+	}
+	{
+		model := Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}
+		mock := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+		mock.Expect(gsorm.UpdateModel(nil, &Employee{EmpNo: 1001, FirstName: "Hanako", Version: 3}, "first_name").
+			Where("emp_no = ?", 1001).Returning("first_name"))
+		if err := gsorm.UpdateModel(mock, &model, "first_name").Where("emp_no = ?", 1001).
+			Returning("first_name").Query(&model); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		if err := mock.Complete(); err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+		}
+		assert.Equal(t, 4, model.Version)
+	}
+}
+
+func TestUpdateStmt_Version_Fail(t *testing.T) {
+	type Employee struct {
+		EmpNo   int
+		Version string `gsorm:"version"`
+	}
+	stmt := gsorm.UpdateModel(nil, &Employee{EmpNo: 1001, Version: "v1"}).Where("emp_no = ?", 1001).(*gsorm.UpdateStmt)
+	_, err := stmt.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "string is invalid type for version", err.Error())
+}
+
+func TestUpdateStmt_Join(t *testing.T) {
+	type Employee struct {
+		FirstName string `gsorm:"e.first_name"`
+	}
+	model := Employee{FirstName: "Hanako"}
+
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
-	}{
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = 1001").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("first_name = ?", "Taro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE first_name = 'Taro'`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE birth_date = '2006-01-02 00:00:00'`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE first_name LIKE '%Taro'`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no BETWEEN 1001 AND 1003`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no IN (1001, 1002)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no IN (1001, 1002)`,
+	}{
+		{
+			gsorm.Update(nil, "employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Set("e.first_name", "Hanako").
+				Where("d.dept_no = ?", "d001").(*gsorm.UpdateStmt),
+			`UPDATE employees AS e INNER JOIN dept_emp AS d ON e.emp_no = d.emp_no ` +
+				`SET e.first_name = 'Hanako' WHERE d.dept_no = 'd001'`,
 		},
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no IN (SELECT emp_no FROM dept_manager)`,
+			gsorm.Update(nil, "employees AS e").
+				LeftJoin("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Model(&model, "e.first_name").
+				Where("d.dept_no IS NULL").(*gsorm.UpdateStmt),
+			`UPDATE employees AS e LEFT JOIN dept_emp AS d ON e.emp_no = d.emp_no ` +
+				`SET e.first_name = 'Hanako' WHERE d.dept_no IS NULL`,
 		},
 	}
 
@@ -1447,102 +4249,20 @@ func TestUpdateStmt_Where(t *testing.T) {
 	}
 }
 
-func TestUpdateStmt_And(t *testing.T) {
+func TestUpdateStmt_From(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no = 1002").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no = 1002)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no = ?", 1002).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no = 1002)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (first_name = 'Taro' OR first_name = 'Jiro')`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no = ?", 1002).
-				And("emp_no = ?", 1003).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no = 1002) ` +
-				`AND (emp_no = 1003)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (birth_date = '2006-01-02 00:00:00')`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (first_name LIKE '%Taro')`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no BETWEEN 1001 AND 1003)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no IN (1001, 1002))`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no IN (1001, 1002))`,
-		},
-		{
-			gsorm.Update(nil, "employees").
+			gsorm.Update(pg, "employees AS e").
 				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				And("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`AND (emp_no IN (SELECT emp_no FROM dept_manager))`,
+				From("dept_emp AS d").
+				Where("e.emp_no = d.emp_no").
+				And("d.dept_no = ?", "d001").(*gsorm.UpdateStmt),
+			`UPDATE employees AS e SET first_name = 'Hanako' FROM dept_emp AS d ` +
+				`WHERE e.emp_no = d.emp_no AND (d.dept_no = 'd001')`,
 		},
 	}
 
@@ -1557,135 +4277,95 @@ func TestUpdateStmt_And(t *testing.T) {
 	}
 }
 
-func TestUpdateStmt_Or(t *testing.T) {
+func TestUpdateStmt_OrderBy(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no = 1002").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no = 1002)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no = ?", 1002).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no = 1002)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("first_name = ? OR first_name = ?", "Taro", "Jiro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (first_name = 'Taro' OR first_name = 'Jiro')`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no = ?", 1002).
-				Or("emp_no = ?", 1003).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no = 1002) ` +
-				`OR (emp_no = 1003)`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("birth_date = ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (birth_date = '2006-01-02 00:00:00')`,
-		},
-		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("first_name LIKE ?", "%Taro").(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (first_name LIKE '%Taro')`,
+			gsorm.Update(nil, "salaries").
+				Set("salary", 0).
+				Where("to_date < ?", "2000-01-01").
+				OrderBy("to_date").
+				Limit(1000).(*gsorm.UpdateStmt),
+			`UPDATE salaries SET salary = 0 WHERE to_date < '2000-01-01' ORDER BY to_date LIMIT 1000`,
 		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestUpdateStmt_Fail(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
+	testCases := []struct {
+		Stmt     *gsorm.UpdateStmt
+		Expected string
+	}{
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no BETWEEN ? AND ?", 1001, 1003).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no BETWEEN 1001 AND 1003)`,
+			gsorm.Update(pg, "employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Set("e.first_name", "Hanako").(*gsorm.UpdateStmt),
+			"JOIN in UPDATE is not supported by postgres",
 		},
 		{
 			gsorm.Update(nil, "employees").
 				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", []int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no IN (1001, 1002))`,
+				From("dept_emp").(*gsorm.UpdateStmt),
+			"FROM in UPDATE is not supported by mysql",
 		},
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", [2]int{1001, 1002}).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no IN (1001, 1002))`,
+			gsorm.Update(pg, "salaries").
+				Set("salary", 0).
+				Limit(10).(*gsorm.UpdateStmt),
+			"LIMIT in UPDATE is not supported by postgres",
 		},
 		{
-			gsorm.Update(nil, "employees").
-				Set("first_name", "Hanako").
-				Where("emp_no = ?", 1001).
-				Or("emp_no IN (?)", gsorm.Select(nil, "emp_no").From("dept_manager")).(*gsorm.UpdateStmt),
-			`UPDATE employees SET first_name = 'Hanako' ` +
-				`WHERE emp_no = 1001 ` +
-				`OR (emp_no IN (SELECT emp_no FROM dept_manager))`,
+			gsorm.Update(nil, "employees AS e").
+				Join("dept_emp AS d").
+				On("e.emp_no = d.emp_no").
+				Set("e.first_name", "Hanako").
+				OrderBy("e.emp_no").(*gsorm.UpdateStmt),
+			"ORDER BY cannot be used with multiple-table UPDATE",
 		},
 	}
 
 	for _, testCase := range testCases {
-		actual := testCase.Stmt.SQL()
-		errs := testCase.Stmt.ExportedGetErrors()
-		if len(errs) > 0 {
-			t.Errorf("Error was occurred: %+v", errs[0])
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
 			continue
 		}
-		assert.Equal(t, testCase.Expected, actual)
+		assert.Equal(t, testCase.Expected, err.Error())
 	}
 }
 
-func TestUpdateStmt_Model(t *testing.T) {
-	type Employee struct {
-		ID        int `gsorm:"emp_no"`
-		FirstName string
-	}
-	structModel := Employee{ID: 1001, FirstName: "Taro"}
-	mapModel := map[string]interface{}{"emp_no": 1001, "first_name": "Taro"}
-
+func TestUpdateStmt_Returning(t *testing.T) {
+	pg := gsorm.OpenMock(gsorm.WithDialect(gsorm.PostgreSQL))
 	testCases := []struct {
 		Stmt     *gsorm.UpdateStmt
 		Expected string
 	}{
 		{
-			gsorm.Update(nil, "employees").Model(&structModel, "emp_no", "first_name").(*gsorm.UpdateStmt),
-			`UPDATE employees SET emp_no = 1001, first_name = 'Taro'`,
+			gsorm.Update(pg, "employees").
+				Set("first_name", "Hanako").
+				Where("emp_no = ?", 1001).
+				Returning("emp_no", "first_name").(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' WHERE emp_no = 1001 RETURNING emp_no, first_name`,
 		},
 		{
-			gsorm.Update(nil, "employees").Model(&mapModel, "emp_no", "first_name").(*gsorm.UpdateStmt),
-			`UPDATE employees SET emp_no = 1001, first_name = 'Taro'`,
+			gsorm.Update(pg, "employees").
+				Set("first_name", "Hanako").
+				Returning().(*gsorm.UpdateStmt),
+			`UPDATE employees SET first_name = 'Hanako' RETURNING *`,
 		},
 	}
 
@@ -1700,6 +4380,19 @@ func TestUpdateStmt_Model(t *testing.T) {
 	}
 }
 
+func TestUpdateStmt_Returning_Fail(t *testing.T) {
+	stmt := gsorm.Update(nil, "employees").
+		Set("first_name", "Hanako").
+		Returning("emp_no").(*gsorm.UpdateStmt)
+
+	_, err := stmt.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "RETURNING is not supported by mysql", err.Error())
+}
+
 func TestFunction_Count(t *testing.T) {
 	testCases := []struct {
 		Stmt     *gsorm.SelectStmt
@@ -1715,7 +4408,7 @@ func TestFunction_Count(t *testing.T) {
 		},
 		{
 			gsorm.Count(nil).From("employees").(*gsorm.SelectStmt),
-			`SELECT * FROM employees`,
+			`SELECT COUNT(*) FROM employees`,
 		},
 	}
 
@@ -1938,3 +4631,93 @@ func TestRawStmt_ExecWithMock(t *testing.T) {
 		t.Errorf("Error was occurred: %v", err)
 	}
 }
+
+func TestSelectStmt_Fingerprint(t *testing.T) {
+	s1 := gsorm.Select(nil, "emp_no").From("employees").
+		Where("emp_no IN (?)", []int{1001, 1002, 1003}).
+		And("first_name = ?", "Taro")
+	s2 := gsorm.Select(nil, "emp_no").From("employees").
+		Where("emp_no IN (?)", []int{1004}).
+		And("first_name = ?", "Jiro")
+
+	f1, err := s1.(*gsorm.SelectStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	f2, err := s2.(*gsorm.SelectStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, "SELECT emp_no FROM employees WHERE emp_no IN (?) AND (first_name = ?)", f1.SQL)
+	assert.Equal(t, f1, f2)
+}
+
+func TestSelectStmt_Fingerprint_Fail(t *testing.T) {
+	testCases := []struct {
+		Stmt          *gsorm.SelectStmt
+		ExpectedError string
+	}{
+		{
+			gsorm.Select(nil).From("employees").Where("emp_no = ?", 1001).
+				SeekAfter("invalid").(*gsorm.SelectStmt),
+			"cursor is invalid",
+		},
+		{
+			gsorm.Select(nil).From("employees").Where("emp_no = ?", 1001, 1002).(*gsorm.SelectStmt),
+			"number of values doesn't match the number of '?'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Stmt.Fingerprint()
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, testCase.ExpectedError, err.Error())
+	}
+}
+
+func TestInsertStmt_Fingerprint(t *testing.T) {
+	s1 := gsorm.Insert(nil, "employees", "emp_no", "first_name").
+		Values(1001, "Taro").
+		Values(1002, "Jiro")
+	s2 := gsorm.Insert(nil, "employees", "emp_no", "first_name").
+		Values(1003, "Saburo")
+
+	f1, err := s1.(*gsorm.InsertStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	f2, err := s2.(*gsorm.InsertStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, "INSERT INTO employees (emp_no, first_name) VALUES (?, ?)", f1.SQL)
+	assert.Equal(t, f1, f2)
+}
+
+func TestUpdateStmt_Fingerprint(t *testing.T) {
+	f, err := gsorm.Update(nil, "employees").
+		Set("first_name", "Hanako").
+		Where("emp_no = ?", 1001).(*gsorm.UpdateStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, "UPDATE employees SET first_name = ? WHERE emp_no = ?", f.SQL)
+}
+
+func TestDeleteStmt_Fingerprint(t *testing.T) {
+	f, err := gsorm.Delete(nil).From("employees").
+		Where("hire_date < ?", time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)).(*gsorm.DeleteStmt).Fingerprint()
+	if err != nil {
+		t.Errorf("Error was occurred: %+v", err)
+		return
+	}
+	assert.Equal(t, "DELETE FROM employees WHERE hire_date < ?", f.SQL)
+}
@@ -0,0 +1,37 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// AndOn is AND clause which extends the condition of ON clause.
+type AndOn struct {
+	Expr   string
+	Values []interface{}
+}
+
+// String returns function call as string.
+func (o *AndOn) String() string {
+	s := fmt.Sprintf("%q", o.Expr)
+	if len(o.Values) > 0 {
+		s += ", "
+		s += internal.ToString(o.Values, &internal.ToStringOpt{DoubleQuotes: true})
+	}
+	return fmt.Sprintf("AndOn(%s)", s)
+}
+
+// Build creates the structure of AND clause that implements interfaces.ClauseSet.
+func (o *AndOn) Build() (interfaces.ClauseSet, error) {
+	s, err := syntax.BuildExprWithoutQuotes(o.Expr, o.Values...)
+	if err != nil {
+		return nil, err
+	}
+	cs := &syntax.ClauseSet{Value: s}
+	cs.WriteKeyword("AND")
+	cs.Parens = true
+	return cs, nil
+}
@@ -0,0 +1,58 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAndOn_String(t *testing.T) {
+	testCases := []struct {
+		AndOn  *clause.AndOn
+		Result string
+	}{
+		{
+			&clause.AndOn{Expr: "table1.column = table2.column"},
+			`AndOn("table1.column = table2.column")`,
+		},
+		{
+			&clause.AndOn{Expr: "table1.column = ?", Values: []interface{}{"table2.column"}},
+			`AndOn("table1.column = ?", "table2.column")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.AndOn.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestAndOn_Build(t *testing.T) {
+	testCases := []struct {
+		AndOn  *clause.AndOn
+		Result *syntax.ClauseSet
+	}{
+		{
+			&clause.AndOn{Expr: "table1.column = table2.column"},
+			&syntax.ClauseSet{Keyword: "AND", Value: "table1.column = table2.column", Parens: true},
+		},
+		{
+			&clause.AndOn{Expr: "table1.column = ?", Values: []interface{}{"table2.column"}},
+			&syntax.ClauseSet{Keyword: "AND", Value: "table1.column = table2.column", Parens: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.AndOn.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
@@ -1,21 +1,45 @@
 package clause
 
 import (
+	"fmt"
+
 	"github.com/champon1020/gsorm/interfaces"
 	"github.com/champon1020/gsorm/syntax"
 )
 
 // Delete is DELETE clause.
-type Delete struct{}
+// Tables are the target tables of multiple-table DELETE.
+type Delete struct {
+	Tables []syntax.Table
+}
+
+// AddTable appends the table to Delete.Tables.
+func (d *Delete) AddTable(table string) {
+	t := syntax.NewTable(table)
+	d.Tables = append(d.Tables, *t)
+}
 
 // String returns function call as string.
 func (d *Delete) String() string {
-	return "Delete()"
+	var s string
+	for i, t := range d.Tables {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", t.Build())
+	}
+	return fmt.Sprintf("Delete(%s)", s)
 }
 
 // Build creates the structure of DELETE clause that implements interfaces.ClauseSet.
 func (d *Delete) Build() (interfaces.ClauseSet, error) {
 	cs := &syntax.ClauseSet{}
 	cs.WriteKeyword("DELETE")
+	for i, t := range d.Tables {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		cs.WriteValue(t.Build())
+	}
 	return cs, nil
 }
@@ -12,6 +12,10 @@ import (
 func TestDelete_String(t *testing.T) {
 	d := new(clause.Delete)
 	assert.Equal(t, "Delete()", d.String())
+
+	d.AddTable("e")
+	d.AddTable("s")
+	assert.Equal(t, `Delete("e", "s")`, d.String())
 }
 
 func TestDelete_Build(t *testing.T) {
@@ -20,6 +24,10 @@ func TestDelete_Build(t *testing.T) {
 		Result *syntax.ClauseSet
 	}{
 		{&clause.Delete{}, &syntax.ClauseSet{Keyword: "DELETE"}},
+		{
+			&clause.Delete{Tables: []syntax.Table{{Name: "e"}, {Name: "s"}}},
+			&syntax.ClauseSet{Keyword: "DELETE", Value: "e, s"},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,21 @@
+package clause
+
+import (
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// DoNothing is DO NOTHING action of ON CONFLICT clause.
+type DoNothing struct{}
+
+// String returns function call as string.
+func (d *DoNothing) String() string {
+	return "DoNothing()"
+}
+
+// Build creates the structure of DO NOTHING action that implements interfaces.ClauseSet.
+func (d *DoNothing) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("DO NOTHING")
+	return cs, nil
+}
@@ -0,0 +1,25 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoNothing_String(t *testing.T) {
+	assert.Equal(t, `DoNothing()`, (&clause.DoNothing{}).String())
+}
+
+func TestDoNothing_Build(t *testing.T) {
+	res, err := (&clause.DoNothing{}).Build()
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+	if diff := cmp.Diff(&syntax.ClauseSet{Keyword: "DO NOTHING"}, res); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
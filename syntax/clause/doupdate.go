@@ -0,0 +1,41 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// DoUpdate is DO UPDATE SET action of ON CONFLICT clause.
+type DoUpdate struct {
+	Column string
+	Value  interface{}
+}
+
+// String returns function call as string.
+func (u *DoUpdate) String() string {
+	v := internal.ToString(u.Value, &internal.ToStringOpt{DoubleQuotes: true})
+	return fmt.Sprintf("DoUpdate(%q, %s)", u.Column, v)
+}
+
+// Build creates the structure of DO UPDATE SET action that implements interfaces.ClauseSet.
+func (u *DoUpdate) Build() (interfaces.ClauseSet, error) {
+	return u.BuildDialect(internal.PostgreSQL)
+}
+
+// BuildDialect creates the structure of DO UPDATE SET action for the dialect.
+// On MySQL, the assignment follows ON DUPLICATE KEY UPDATE without keyword.
+func (u *DoUpdate) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	if !d.IsMySQL() {
+		cs.WriteKeyword("DO UPDATE SET")
+	}
+	v, err := internal.FormatValue(u.Value, nil)
+	if err != nil {
+		return nil, err
+	}
+	cs.WriteValue(fmt.Sprintf("%s = %s", u.Column, v))
+	return cs, nil
+}
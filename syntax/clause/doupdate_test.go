@@ -0,0 +1,67 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoUpdate_String(t *testing.T) {
+	testCases := []struct {
+		DoUpdate *clause.DoUpdate
+		Result   string
+	}{
+		{
+			&clause.DoUpdate{Column: "col", Value: 10},
+			`DoUpdate("col", 10)`,
+		},
+		{
+			&clause.DoUpdate{Column: "col", Value: "str"},
+			`DoUpdate("col", "str")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.DoUpdate.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestDoUpdate_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		DoUpdate *clause.DoUpdate
+		Dialect  internal.Dialect
+		Result   *syntax.ClauseSet
+	}{
+		{
+			&clause.DoUpdate{Column: "lhs", Value: "rhs"},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "DO UPDATE SET", Value: `lhs = 'rhs'`},
+		},
+		{
+			&clause.DoUpdate{Column: "lhs", Value: 10},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "DO UPDATE SET", Value: `lhs = 10`},
+		},
+		{
+			&clause.DoUpdate{Column: "lhs", Value: "rhs"},
+			internal.MySQL,
+			&syntax.ClauseSet{Value: `lhs = 'rhs'`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.DoUpdate.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
+)
+
+// Except is EXCEPT clause.
+type Except struct {
+	Stmt interfaces.Stmt
+	All  bool
+}
+
+// Keyword returns clause keyword.
+func (e *Except) Keyword() string {
+	n := "EXCEPT"
+	if e.All {
+		n += " ALL"
+	}
+	return n
+}
+
+// String returns function call as string.
+func (e *Except) String() string {
+	keyword := "Except"
+	if e.All {
+		keyword += "All"
+	}
+	return fmt.Sprintf("%s(%q)", keyword, e.Stmt.SQL())
+}
+
+// Build creates the structure of EXCEPT clause that implements interfaces.ClauseSet.
+func (e *Except) Build() (interfaces.ClauseSet, error) {
+	return e.BuildDialect(internal.MySQL)
+}
+
+// BuildDialect creates the structure of EXCEPT clause for the dialect.
+// MySQL 5.7 or older doesn't support EXCEPT, and SQLite doesn't support EXCEPT ALL.
+func (e *Except) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d == internal.MySQL5 || (d == internal.SQLite && e.All) {
+		return nil, xerrors.Errorf("%s is not supported by %s", e.Keyword(), d)
+	}
+	return buildSetOperation(e.Keyword(), e.Stmt, d)
+}
@@ -0,0 +1,92 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcept_String(t *testing.T) {
+	testCases := []struct {
+		Except *clause.Except
+		Result string
+	}{
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table")},
+			`Except("SELECT * FROM table")`,
+		},
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			`ExceptAll("SELECT * FROM table")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Except.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestExcept_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		Except  *clause.Except
+		Dialect internal.Dialect
+		Result  *syntax.ClauseSet
+	}{
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.MySQL,
+			&syntax.ClauseSet{Keyword: "EXCEPT", Value: "(SELECT * FROM table)"},
+		},
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "EXCEPT ALL", Value: "(SELECT * FROM table)"},
+		},
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "EXCEPT", Value: "SELECT * FROM table"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Except.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestExcept_BuildDialect_Fail(t *testing.T) {
+	testCases := []struct {
+		Except  *clause.Except
+		Dialect internal.Dialect
+		Error   string
+	}{
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.MySQL5,
+			"EXCEPT is not supported by mysql5",
+		},
+		{
+			&clause.Except{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			internal.SQLite,
+			"EXCEPT ALL is not supported by sqlite3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Except.BuildDialect(testCase.Dialect)
+		assert.EqualError(t, err, testCase.Error)
+	}
+}
@@ -0,0 +1,92 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntersect_String(t *testing.T) {
+	testCases := []struct {
+		Intersect *clause.Intersect
+		Result    string
+	}{
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table")},
+			`Intersect("SELECT * FROM table")`,
+		},
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			`IntersectAll("SELECT * FROM table")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Intersect.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestIntersect_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		Intersect *clause.Intersect
+		Dialect   internal.Dialect
+		Result    *syntax.ClauseSet
+	}{
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.MySQL,
+			&syntax.ClauseSet{Keyword: "INTERSECT", Value: "(SELECT * FROM table)"},
+		},
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "INTERSECT ALL", Value: "(SELECT * FROM table)"},
+		},
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "INTERSECT", Value: "SELECT * FROM table"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Intersect.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestIntersect_BuildDialect_Fail(t *testing.T) {
+	testCases := []struct {
+		Intersect *clause.Intersect
+		Dialect   internal.Dialect
+		Error     string
+	}{
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.MySQL5,
+			"INTERSECT is not supported by mysql5",
+		},
+		{
+			&clause.Intersect{Stmt: gsorm.Select(nil, "*").From("table"), All: true},
+			internal.SQLite,
+			"INTERSECT ALL is not supported by sqlite3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Intersect.BuildDialect(testCase.Dialect)
+		assert.EqualError(t, err, testCase.Error)
+	}
+}
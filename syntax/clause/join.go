@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
 	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
 )
 
 // JoinType is type of JOIN clause.
@@ -12,15 +14,21 @@ type JoinType string
 
 // Types of JOIN clause.
 const (
-	InnerJoin JoinType = "INNER JOIN"
-	LeftJoin  JoinType = "LEFT JOIN"
-	RightJoin JoinType = "RIGHT JOIN"
+	InnerJoin   JoinType = "INNER JOIN"
+	LeftJoin    JoinType = "LEFT JOIN"
+	RightJoin   JoinType = "RIGHT JOIN"
+	FullJoin    JoinType = "FULL OUTER JOIN"
+	CrossJoin   JoinType = "CROSS JOIN"
+	NaturalJoin JoinType = "NATURAL JOIN"
 )
 
 // Join is JOIN clause.
+// If Stmt is not nil, the subquery is joined instead of Table and Table.Alias is used as its alias.
 type Join struct {
-	Table syntax.Table
-	Type  JoinType
+	Table   syntax.Table
+	Type    JoinType
+	Stmt    interfaces.Stmt
+	Lateral bool
 }
 
 // AddTable assigns the table to Join.Table.
@@ -28,25 +36,63 @@ func (j *Join) AddTable(table string) {
 	j.Table = *syntax.NewTable(table)
 }
 
+// AddStmt assigns the subquery and its alias to Join.
+func (j *Join) AddStmt(stmt interfaces.Stmt, alias string) {
+	j.Stmt = stmt
+	j.Table = syntax.Table{Alias: alias}
+}
+
 // String returns function call as string.
 func (j *Join) String() string {
 	var keyword string
-	if j.Type == InnerJoin {
+	switch j.Type {
+	case InnerJoin:
 		keyword = "Join"
-	}
-	if j.Type == LeftJoin {
+	case LeftJoin:
 		keyword = "LeftJoin"
-	}
-	if j.Type == RightJoin {
+	case RightJoin:
 		keyword = "RightJoin"
+	case FullJoin:
+		keyword = "FullJoin"
+	case CrossJoin:
+		keyword = "CrossJoin"
+	case NaturalJoin:
+		keyword = "NaturalJoin"
+	}
+	if j.Stmt == nil {
+		return fmt.Sprintf("%s(%q)", keyword, j.Table.Build())
+	}
+	if j.Lateral {
+		keyword += "Lateral"
+	} else {
+		keyword += "Sub"
 	}
-	return fmt.Sprintf("%s(%q)", keyword, j.Table.Build())
+	return fmt.Sprintf("%s(%q, %q)", keyword, j.Stmt.SQL(), j.Table.Alias)
 }
 
 // Build creates the structure of JOIN clause that implements interfaces.ClauseSet.
 func (j *Join) Build() (interfaces.ClauseSet, error) {
 	cs := &syntax.ClauseSet{}
 	cs.WriteKeyword(string(j.Type))
-	cs.WriteValue(j.Table.Build())
+	if j.Stmt == nil {
+		cs.WriteValue(j.Table.Build())
+		return cs, nil
+	}
+	if j.Lateral {
+		cs.WriteKeyword("LATERAL")
+	}
+	cs.WriteValue(fmt.Sprintf("(%s)", j.Stmt.SQL()))
+	if j.Table.Alias != "" {
+		cs.WriteValue(fmt.Sprintf("AS %s", j.Table.Alias))
+	}
 	return cs, nil
 }
+
+// BuildDialect creates the structure of JOIN clause for the dialect.
+// MySQL doesn't support FULL OUTER JOIN.
+func (j *Join) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if j.Type == FullJoin && d.IsMySQL() {
+		return nil, xerrors.Errorf("%s is not supported by %s", j.Type, d)
+	}
+	return j.Build()
+}
@@ -3,6 +3,8 @@ package clause_test
 import (
 	"testing"
 
+	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/internal"
 	"github.com/champon1020/gsorm/syntax"
 	"github.com/champon1020/gsorm/syntax/clause"
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +28,15 @@ func TestJoin_AddTable(t *testing.T) {
 	}
 }
 
+func TestJoin_AddStmt(t *testing.T) {
+	j := &clause.Join{}
+	stmt := gsorm.Select(nil).From("table")
+	j.AddStmt(stmt, "t")
+
+	assert.Equal(t, j.Table, syntax.Table{Alias: "t"})
+	assert.Equal(t, j.Stmt, stmt)
+}
+
 func TestJoin_String(t *testing.T) {
 	testCases := []struct {
 		Join   *clause.Join
@@ -43,6 +54,35 @@ func TestJoin_String(t *testing.T) {
 			&clause.Join{Table: syntax.Table{Name: "table", Alias: "t"}, Type: clause.RightJoin},
 			`RightJoin("table AS t")`,
 		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.FullJoin},
+			`FullJoin("table")`,
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.CrossJoin},
+			`CrossJoin("table")`,
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.NaturalJoin},
+			`NaturalJoin("table")`,
+		},
+		{
+			&clause.Join{
+				Table: syntax.Table{Alias: "t"},
+				Type:  clause.InnerJoin,
+				Stmt:  gsorm.Select(nil).From("table"),
+			},
+			`JoinSub("SELECT * FROM table", "t")`,
+		},
+		{
+			&clause.Join{
+				Table:   syntax.Table{Alias: "t"},
+				Type:    clause.LeftJoin,
+				Stmt:    gsorm.Select(nil).From("table"),
+				Lateral: true,
+			},
+			`LeftJoinLateral("SELECT * FROM table", "t")`,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -64,6 +104,35 @@ func TestJoin_Build(t *testing.T) {
 			&clause.Join{Table: syntax.Table{Name: "table", Alias: "t"}, Type: clause.LeftJoin},
 			&syntax.ClauseSet{Keyword: "LEFT JOIN", Value: "table AS t"},
 		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.FullJoin},
+			&syntax.ClauseSet{Keyword: "FULL OUTER JOIN", Value: "table"},
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.CrossJoin},
+			&syntax.ClauseSet{Keyword: "CROSS JOIN", Value: "table"},
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.NaturalJoin},
+			&syntax.ClauseSet{Keyword: "NATURAL JOIN", Value: "table"},
+		},
+		{
+			&clause.Join{
+				Table: syntax.Table{Alias: "t"},
+				Type:  clause.InnerJoin,
+				Stmt:  gsorm.Select(nil).From("table"),
+			},
+			&syntax.ClauseSet{Keyword: "INNER JOIN", Value: "(SELECT * FROM table) AS t"},
+		},
+		{
+			&clause.Join{
+				Table:   syntax.Table{Alias: "t"},
+				Type:    clause.LeftJoin,
+				Stmt:    gsorm.Select(nil).From("table"),
+				Lateral: true,
+			},
+			&syntax.ClauseSet{Keyword: "LEFT JOIN LATERAL", Value: "(SELECT * FROM table) AS t"},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -77,3 +146,57 @@ func TestJoin_Build(t *testing.T) {
 		}
 	}
 }
+
+func TestJoin_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		Join    *clause.Join
+		Dialect internal.Dialect
+		Result  *syntax.ClauseSet
+	}{
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.FullJoin},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "FULL OUTER JOIN", Value: "table"},
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.InnerJoin},
+			internal.MySQL,
+			&syntax.ClauseSet{Keyword: "INNER JOIN", Value: "table"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Join.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestJoin_BuildDialect_Fail(t *testing.T) {
+	testCases := []struct {
+		Join    *clause.Join
+		Dialect internal.Dialect
+		Error   string
+	}{
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.FullJoin},
+			internal.MySQL,
+			"FULL OUTER JOIN is not supported by mysql",
+		},
+		{
+			&clause.Join{Table: syntax.Table{Name: "table"}, Type: clause.FullJoin},
+			internal.MySQL5,
+			"FULL OUTER JOIN is not supported by mysql5",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.Join.BuildDialect(testCase.Dialect)
+		assert.EqualError(t, err, testCase.Error)
+	}
+}
@@ -0,0 +1,53 @@
+package clause
+
+import (
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// LockStrength is strength of the row locking clause.
+type LockStrength string
+
+// Strengths of the row locking clause.
+const (
+	ForUpdate LockStrength = "FOR UPDATE"
+	ForShare  LockStrength = "FOR SHARE"
+)
+
+// Lock is FOR UPDATE or FOR SHARE clause.
+type Lock struct {
+	Strength LockStrength
+}
+
+// String returns function call as string.
+func (l *Lock) String() string {
+	if l.Strength == ForShare {
+		return "ForShare()"
+	}
+	return "ForUpdate()"
+}
+
+// Build creates the structure of FOR UPDATE or FOR SHARE clause that implements interfaces.ClauseSet.
+func (l *Lock) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword(string(l.Strength))
+	return cs, nil
+}
+
+// BuildDialect creates the structure of FOR UPDATE or FOR SHARE clause for the dialect.
+// On older MySQL, FOR SHARE is replaced with LOCK IN SHARE MODE.
+func (l *Lock) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	switch d {
+	case internal.SQLite:
+		return nil, xerrors.Errorf("%s is not supported by %s", l.Strength, d)
+	case internal.MySQL5:
+		if l.Strength == ForShare {
+			cs := &syntax.ClauseSet{}
+			cs.WriteKeyword("LOCK IN SHARE MODE")
+			return cs, nil
+		}
+	}
+	return l.Build()
+}
@@ -0,0 +1,98 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock_String(t *testing.T) {
+	testCases := []struct {
+		Lock   *clause.Lock
+		Result string
+	}{
+		{&clause.Lock{Strength: clause.ForUpdate}, `ForUpdate()`},
+		{&clause.Lock{Strength: clause.ForShare}, `ForShare()`},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Lock.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestLock_Build(t *testing.T) {
+	testCases := []struct {
+		Lock   *clause.Lock
+		Result *syntax.ClauseSet
+	}{
+		{
+			&clause.Lock{Strength: clause.ForUpdate},
+			&syntax.ClauseSet{Keyword: "FOR UPDATE"},
+		},
+		{
+			&clause.Lock{Strength: clause.ForShare},
+			&syntax.ClauseSet{Keyword: "FOR SHARE"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Lock.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestLock_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		Lock    *clause.Lock
+		Dialect internal.Dialect
+		Result  *syntax.ClauseSet
+	}{
+		{
+			&clause.Lock{Strength: clause.ForShare},
+			internal.MySQL,
+			&syntax.ClauseSet{Keyword: "FOR SHARE"},
+		},
+		{
+			&clause.Lock{Strength: clause.ForShare},
+			internal.MySQL5,
+			&syntax.ClauseSet{Keyword: "LOCK IN SHARE MODE"},
+		},
+		{
+			&clause.Lock{Strength: clause.ForUpdate},
+			internal.MySQL5,
+			&syntax.ClauseSet{Keyword: "FOR UPDATE"},
+		},
+		{
+			&clause.Lock{Strength: clause.ForShare},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "FOR SHARE"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Lock.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestLock_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.Lock{Strength: clause.ForUpdate}).BuildDialect(internal.SQLite)
+	assert.EqualError(t, err, "FOR UPDATE is not supported by sqlite3")
+}
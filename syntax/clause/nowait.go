@@ -0,0 +1,31 @@
+package clause
+
+import (
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// NoWait is NOWAIT option of the row locking clause.
+type NoWait struct{}
+
+// String returns function call as string.
+func (n *NoWait) String() string {
+	return "NoWait()"
+}
+
+// Build creates the structure of NOWAIT option that implements interfaces.ClauseSet.
+func (n *NoWait) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("NOWAIT")
+	return cs, nil
+}
+
+// BuildDialect creates the structure of NOWAIT option for the dialect.
+func (n *NoWait) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d == internal.MySQL5 || d == internal.SQLite {
+		return nil, xerrors.Errorf("NOWAIT is not supported by %s", d)
+	}
+	return n.Build()
+}
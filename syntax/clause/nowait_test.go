@@ -0,0 +1,31 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoWait_String(t *testing.T) {
+	assert.Equal(t, `NoWait()`, (&clause.NoWait{}).String())
+}
+
+func TestNoWait_Build(t *testing.T) {
+	res, err := (&clause.NoWait{}).Build()
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+	if diff := cmp.Diff(&syntax.ClauseSet{Keyword: "NOWAIT"}, res); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestNoWait_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.NoWait{}).BuildDialect(internal.MySQL5)
+	assert.EqualError(t, err, "NOWAIT is not supported by mysql5")
+}
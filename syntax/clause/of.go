@@ -0,0 +1,48 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// Of is OF clause of the row locking clause.
+type Of struct {
+	Tables []string
+}
+
+// String returns function call as string.
+func (o *Of) String() string {
+	var s string
+	for i, t := range o.Tables {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", t)
+	}
+	return fmt.Sprintf("Of(%s)", s)
+}
+
+// Build creates the structure of OF clause that implements interfaces.ClauseSet.
+func (o *Of) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("OF")
+	for i, t := range o.Tables {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		cs.WriteValue(t)
+	}
+	return cs, nil
+}
+
+// BuildDialect creates the structure of OF clause for the dialect.
+func (o *Of) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d == internal.MySQL5 || d == internal.SQLite {
+		return nil, xerrors.Errorf("OF is not supported by %s", d)
+	}
+	return o.Build()
+}
@@ -0,0 +1,58 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOf_String(t *testing.T) {
+	testCases := []struct {
+		Of     *clause.Of
+		Result string
+	}{
+		{&clause.Of{Tables: []string{"jobs"}}, `Of("jobs")`},
+		{&clause.Of{Tables: []string{"jobs", "workers"}}, `Of("jobs", "workers")`},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Of.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestOf_Build(t *testing.T) {
+	testCases := []struct {
+		Of     *clause.Of
+		Result *syntax.ClauseSet
+	}{
+		{
+			&clause.Of{Tables: []string{"jobs"}},
+			&syntax.ClauseSet{Keyword: "OF", Value: "jobs"},
+		},
+		{
+			&clause.Of{Tables: []string{"jobs", "workers"}},
+			&syntax.ClauseSet{Keyword: "OF", Value: "jobs, workers"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Of.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestOf_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.Of{Tables: []string{"jobs"}}).BuildDialect(internal.MySQL5)
+	assert.EqualError(t, err, "OF is not supported by mysql5")
+}
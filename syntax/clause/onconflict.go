@@ -0,0 +1,53 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// OnConflict is ON CONFLICT clause.
+// On MySQL, it is built as ON DUPLICATE KEY UPDATE clause and Columns are ignored.
+type OnConflict struct {
+	Columns []string
+}
+
+// String returns function call as string.
+func (o *OnConflict) String() string {
+	var s string
+	for i, c := range o.Columns {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", c)
+	}
+	return fmt.Sprintf("OnConflict(%s)", s)
+}
+
+// Build creates the structure of ON CONFLICT clause that implements interfaces.ClauseSet.
+func (o *OnConflict) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("ON CONFLICT")
+	if len(o.Columns) > 0 {
+		cs.Parens = true
+		for i, c := range o.Columns {
+			if i != 0 {
+				cs.WriteValue(",")
+			}
+			cs.WriteValue(c)
+		}
+	}
+	return cs, nil
+}
+
+// BuildDialect creates the structure of ON CONFLICT clause for the dialect.
+func (o *OnConflict) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d.IsMySQL() {
+		cs := &syntax.ClauseSet{}
+		cs.WriteKeyword("ON DUPLICATE KEY UPDATE")
+		return cs, nil
+	}
+	return o.Build()
+}
@@ -0,0 +1,89 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnConflict_String(t *testing.T) {
+	testCases := []struct {
+		OnConflict *clause.OnConflict
+		Result     string
+	}{
+		{
+			&clause.OnConflict{},
+			`OnConflict()`,
+		},
+		{
+			&clause.OnConflict{Columns: []string{"emp_no", "dept_no"}},
+			`OnConflict("emp_no", "dept_no")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.OnConflict.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestOnConflict_Build(t *testing.T) {
+	testCases := []struct {
+		OnConflict *clause.OnConflict
+		Result     *syntax.ClauseSet
+	}{
+		{
+			&clause.OnConflict{},
+			&syntax.ClauseSet{Keyword: "ON CONFLICT"},
+		},
+		{
+			&clause.OnConflict{Columns: []string{"emp_no", "dept_no"}},
+			&syntax.ClauseSet{Keyword: "ON CONFLICT", Value: "emp_no, dept_no", Parens: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.OnConflict.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestOnConflict_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		OnConflict *clause.OnConflict
+		Dialect    internal.Dialect
+		Result     *syntax.ClauseSet
+	}{
+		{
+			&clause.OnConflict{Columns: []string{"emp_no"}},
+			internal.MySQL,
+			&syntax.ClauseSet{Keyword: "ON DUPLICATE KEY UPDATE"},
+		},
+		{
+			&clause.OnConflict{Columns: []string{"emp_no"}},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "ON CONFLICT", Value: "emp_no", Parens: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.OnConflict.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrOn_String(t *testing.T) {
+	testCases := []struct {
+		OrOn   *clause.OrOn
+		Result string
+	}{
+		{
+			&clause.OrOn{Expr: "table1.column = table2.column"},
+			`OrOn("table1.column = table2.column")`,
+		},
+		{
+			&clause.OrOn{Expr: "table1.column = ?", Values: []interface{}{"table2.column"}},
+			`OrOn("table1.column = ?", "table2.column")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.OrOn.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestOrOn_Build(t *testing.T) {
+	testCases := []struct {
+		OrOn   *clause.OrOn
+		Result *syntax.ClauseSet
+	}{
+		{
+			&clause.OrOn{Expr: "table1.column = table2.column"},
+			&syntax.ClauseSet{Keyword: "OR", Value: "table1.column = table2.column", Parens: true},
+		},
+		{
+			&clause.OrOn{Expr: "table1.column = ?", Values: []interface{}{"table2.column"}},
+			&syntax.ClauseSet{Keyword: "OR", Value: "table1.column = table2.column", Parens: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.OrOn.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
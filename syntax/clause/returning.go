@@ -0,0 +1,53 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// Returning is RETURNING clause.
+// If Columns is empty, all columns are returned.
+type Returning struct {
+	Columns []string
+}
+
+// String returns function call as string.
+func (r *Returning) String() string {
+	var s string
+	for i, c := range r.Columns {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", c)
+	}
+	return fmt.Sprintf("Returning(%s)", s)
+}
+
+// Build creates the structure of RETURNING clause that implements interfaces.ClauseSet.
+func (r *Returning) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("RETURNING")
+	if len(r.Columns) == 0 {
+		cs.WriteValue("*")
+		return cs, nil
+	}
+	for i, c := range r.Columns {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		cs.WriteValue(c)
+	}
+	return cs, nil
+}
+
+// BuildDialect creates the structure of RETURNING clause for the dialect.
+func (r *Returning) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d.IsMySQL() {
+		return nil, xerrors.Errorf("RETURNING is not supported by %s", d)
+	}
+	return r.Build()
+}
@@ -0,0 +1,64 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturning_String(t *testing.T) {
+	testCases := []struct {
+		Returning *clause.Returning
+		Result    string
+	}{
+		{
+			&clause.Returning{},
+			`Returning()`,
+		},
+		{
+			&clause.Returning{Columns: []string{"emp_no", "first_name"}},
+			`Returning("emp_no", "first_name")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Returning.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestReturning_Build(t *testing.T) {
+	testCases := []struct {
+		Returning *clause.Returning
+		Result    *syntax.ClauseSet
+	}{
+		{
+			&clause.Returning{},
+			&syntax.ClauseSet{Keyword: "RETURNING", Value: "*"},
+		},
+		{
+			&clause.Returning{Columns: []string{"emp_no", "first_name"}},
+			&syntax.ClauseSet{Keyword: "RETURNING", Value: "emp_no, first_name"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Returning.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestReturning_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.Returning{}).BuildDialect(internal.MySQL)
+	assert.EqualError(t, err, "RETURNING is not supported by mysql")
+}
@@ -0,0 +1,61 @@
+package clause
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// SeekAfter is the condition of keyset pagination which selects the rows after the cursor.
+type SeekAfter struct {
+	Cursor  string
+	Columns []string
+	Desc    []bool
+	Values  []interface{}
+}
+
+// String returns function call as string.
+func (s *SeekAfter) String() string {
+	return fmt.Sprintf("SeekAfter(%q)", s.Cursor)
+}
+
+// Condition returns the expression and the values which select the rows after the cursor.
+// For example, the expression for the columns (a, b DESC) is "a > ? OR (a = ? AND b < ?)".
+func (s *SeekAfter) Condition() (string, []interface{}) {
+	terms := []string{}
+	values := []interface{}{}
+	for i, c := range s.Columns {
+		conds := []string{}
+		for j := 0; j < i; j++ {
+			conds = append(conds, fmt.Sprintf("%s = ?", s.Columns[j]))
+			values = append(values, s.Values[j])
+		}
+		op := ">"
+		if s.Desc[i] {
+			op = "<"
+		}
+		conds = append(conds, fmt.Sprintf("%s %s ?", c, op))
+		values = append(values, s.Values[i])
+
+		term := strings.Join(conds, " AND ")
+		if len(conds) > 1 {
+			term = fmt.Sprintf("(%s)", term)
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " OR "), values
+}
+
+// Build creates the structure of WHERE clause with the keyset condition that implements interfaces.ClauseSet.
+func (s *SeekAfter) Build() (interfaces.ClauseSet, error) {
+	expr, values := s.Condition()
+	str, err := syntax.BuildExpr(expr, values...)
+	if err != nil {
+		return nil, err
+	}
+	cs := &syntax.ClauseSet{Value: str}
+	cs.WriteKeyword("WHERE")
+	return cs, nil
+}
@@ -0,0 +1,72 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeekAfter_String(t *testing.T) {
+	s := &clause.SeekAfter{Cursor: "WyJpOjEwMDEiXQ"}
+	assert.Equal(t, `SeekAfter("WyJpOjEwMDEiXQ")`, s.String())
+}
+
+func TestSeekAfter_Build(t *testing.T) {
+	testCases := []struct {
+		SeekAfter *clause.SeekAfter
+		Result    *syntax.ClauseSet
+	}{
+		{
+			&clause.SeekAfter{
+				Columns: []string{"emp_no"},
+				Desc:    []bool{false},
+				Values:  []interface{}{1001},
+			},
+			&syntax.ClauseSet{Keyword: "WHERE", Value: "emp_no > 1001"},
+		},
+		{
+			&clause.SeekAfter{
+				Columns: []string{"emp_no"},
+				Desc:    []bool{true},
+				Values:  []interface{}{1001},
+			},
+			&syntax.ClauseSet{Keyword: "WHERE", Value: "emp_no < 1001"},
+		},
+		{
+			&clause.SeekAfter{
+				Columns: []string{"last_name", "emp_no"},
+				Desc:    []bool{false, true},
+				Values:  []interface{}{"Yamada", 1001},
+			},
+			&syntax.ClauseSet{
+				Keyword: "WHERE",
+				Value:   "last_name > 'Yamada' OR (last_name = 'Yamada' AND emp_no < 1001)",
+			},
+		},
+		{
+			&clause.SeekAfter{
+				Columns: []string{"a", "b", "c"},
+				Desc:    []bool{false, false, false},
+				Values:  []interface{}{1, 2, 3},
+			},
+			&syntax.ClauseSet{
+				Keyword: "WHERE",
+				Value:   "a > 1 OR (a = 1 AND b > 2) OR (a = 1 AND b = 2 AND c > 3)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.SeekAfter.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %+v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
@@ -2,14 +2,18 @@ package clause
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/champon1020/gsorm/interfaces"
 	"github.com/champon1020/gsorm/syntax"
 )
 
 // Select is SELECT clause.
+// ModelColumns are the columns which are mapped to the fields of the model.
+// They are written after Columns and can be qualified with the table name by Qualify.
 type Select struct {
-	Columns []syntax.Column
+	Columns      []syntax.Column
+	ModelColumns []string
 }
 
 // AddColumns appends the columns to Select.Columns.
@@ -20,14 +24,37 @@ func (s *Select) AddColumns(cols ...string) {
 	}
 }
 
+// Qualify returns the copy of Select whose model columns are qualified with the table name like "e.emp_no".
+// The columns which are already qualified are kept.
+func (s *Select) Qualify(table string) *Select {
+	c := &Select{Columns: s.Columns, ModelColumns: make([]string, len(s.ModelColumns))}
+	for i, col := range s.ModelColumns {
+		if strings.Contains(col, ".") {
+			c.ModelColumns[i] = col
+			continue
+		}
+		c.ModelColumns[i] = fmt.Sprintf("%s.%s", table, col)
+	}
+	return c
+}
+
+// columns returns all columns as string.
+func (s *Select) columns() []string {
+	cols := make([]string, 0, len(s.Columns)+len(s.ModelColumns))
+	for _, c := range s.Columns {
+		cols = append(cols, c.Build())
+	}
+	return append(cols, s.ModelColumns...)
+}
+
 // String returns function call as string.
 func (s *Select) String() string {
 	var str string
-	for i, c := range s.Columns {
+	for i, c := range s.columns() {
 		if i != 0 {
 			str += ", "
 		}
-		str += fmt.Sprintf("%q", c.Build())
+		str += fmt.Sprintf("%q", c)
 	}
 	return fmt.Sprintf("Select(%s)", str)
 }
@@ -36,11 +63,11 @@ func (s *Select) String() string {
 func (s *Select) Build() (interfaces.ClauseSet, error) {
 	cs := &syntax.ClauseSet{}
 	cs.WriteKeyword("SELECT")
-	for i, c := range s.Columns {
+	for i, c := range s.columns() {
 		if i != 0 {
 			cs.WriteValue(",")
 		}
-		cs.WriteValue(c.Build())
+		cs.WriteValue(c)
 	}
 	return cs, nil
 }
@@ -29,6 +29,10 @@ func TestSelect_String(t *testing.T) {
 			}},
 			`Select("column1 AS c1", "column2 AS c2")`,
 		},
+		{
+			&clause.Select{Columns: []syntax.Column{{Name: "COUNT(*)"}}, ModelColumns: []string{"emp_no", "first_name"}},
+			`Select("COUNT(*)", "emp_no", "first_name")`,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -57,6 +61,10 @@ func TestSelect_Build(t *testing.T) {
 			}},
 			&syntax.ClauseSet{Keyword: "SELECT", Value: "column1 AS c1, column2 AS c2"},
 		},
+		{
+			&clause.Select{ModelColumns: []string{"emp_no", "first_name"}},
+			&syntax.ClauseSet{Keyword: "SELECT", Value: "emp_no, first_name"},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -70,3 +78,18 @@ func TestSelect_Build(t *testing.T) {
 		}
 	}
 }
+
+func TestSelect_Qualify(t *testing.T) {
+	s := &clause.Select{
+		Columns:      []syntax.Column{{Name: "COUNT(*)"}},
+		ModelColumns: []string{"emp_no", "s.salary"},
+	}
+	expected := &clause.Select{
+		Columns:      []syntax.Column{{Name: "COUNT(*)"}},
+		ModelColumns: []string{"e.emp_no", "s.salary"},
+	}
+	if diff := cmp.Diff(expected, s.Qualify("e")); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+	assert.Equal(t, []string{"emp_no", "s.salary"}, s.ModelColumns)
+}
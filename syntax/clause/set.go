@@ -23,7 +23,18 @@ func (s *Set) String() string {
 func (s *Set) Build() (interfaces.ClauseSet, error) {
 	cs := &syntax.ClauseSet{}
 	cs.WriteKeyword("SET")
-	v := internal.ToString(s.Value, nil)
+	var (
+		v   string
+		err error
+	)
+	if e, ok := s.Value.(interfaces.Expr); ok {
+		v, err = syntax.BuildExpr("?", e)
+	} else {
+		v, err = internal.FormatValue(s.Value, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
 	cs.WriteValue(fmt.Sprintf("%s = %s", s.Column, v))
 	return cs, nil
 }
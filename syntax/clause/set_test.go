@@ -3,6 +3,7 @@ package clause_test
 import (
 	"testing"
 
+	"github.com/champon1020/gsorm/expr"
 	"github.com/champon1020/gsorm/syntax"
 	"github.com/champon1020/gsorm/syntax/clause"
 	"github.com/google/go-cmp/cmp"
@@ -39,6 +40,10 @@ func TestSet_Build(t *testing.T) {
 			&clause.Set{Column: "lhs1", Value: 10},
 			&syntax.ClauseSet{Keyword: "SET", Value: `lhs1 = 10`},
 		},
+		{
+			&clause.Set{Column: "lhs", Value: expr.Mul("salary", 1.1)},
+			&syntax.ClauseSet{Keyword: "SET", Value: `lhs = (salary * 1.1)`},
+		},
 	}
 
 	for _, testCase := range testCases {
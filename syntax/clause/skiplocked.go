@@ -0,0 +1,31 @@
+package clause
+
+import (
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// SkipLocked is SKIP LOCKED option of the row locking clause.
+type SkipLocked struct{}
+
+// String returns function call as string.
+func (n *SkipLocked) String() string {
+	return "SkipLocked()"
+}
+
+// Build creates the structure of SKIP LOCKED option that implements interfaces.ClauseSet.
+func (n *SkipLocked) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("SKIP LOCKED")
+	return cs, nil
+}
+
+// BuildDialect creates the structure of SKIP LOCKED option for the dialect.
+func (n *SkipLocked) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d == internal.MySQL5 || d == internal.SQLite {
+		return nil, xerrors.Errorf("SKIP LOCKED is not supported by %s", d)
+	}
+	return n.Build()
+}
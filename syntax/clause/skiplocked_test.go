@@ -0,0 +1,31 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipLocked_String(t *testing.T) {
+	assert.Equal(t, `SkipLocked()`, (&clause.SkipLocked{}).String())
+}
+
+func TestSkipLocked_Build(t *testing.T) {
+	res, err := (&clause.SkipLocked{}).Build()
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+	if diff := cmp.Diff(&syntax.ClauseSet{Keyword: "SKIP LOCKED"}, res); diff != "" {
+		t.Errorf("Differs: (-want +got)\n%s", diff)
+	}
+}
+
+func TestSkipLocked_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.SkipLocked{}).BuildDialect(internal.MySQL5)
+	assert.EqualError(t, err, "SKIP LOCKED is not supported by mysql5")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
 	"github.com/champon1020/gsorm/syntax"
 )
 
@@ -33,8 +34,49 @@ func (u *Union) String() string {
 
 // Build creates the structure of UNION clause that implements interfaces.ClauseSet.
 func (u *Union) Build() (interfaces.ClauseSet, error) {
+	return u.BuildDialect(internal.MySQL)
+}
+
+// BuildDialect creates the structure of UNION clause for the dialect.
+func (u *Union) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	return buildSetOperation(u.Keyword(), u.Stmt, d)
+}
+
+// IsSetOperation reports whether the clause combines the results of statements,
+// like UNION, INTERSECT and EXCEPT.
+func IsSetOperation(e interfaces.Clause) bool {
+	switch e.(type) {
+	case *Union, *Intersect, *Except:
+		return true
+	}
+	return false
+}
+
+// buildSetOperation creates the structure of set operation clause for the dialect.
+// Except SQLite, the statement is enclosed by parentheses.
+// SQLite doesn't allow the parentheses, so the statement which has ORDER BY, LIMIT or OFFSET is built as subquery.
+func buildSetOperation(keyword string, stmt interfaces.Stmt, d internal.Dialect) (interfaces.ClauseSet, error) {
 	cs := &syntax.ClauseSet{}
-	cs.WriteKeyword(u.Keyword())
-	cs.WriteValue(fmt.Sprintf("(%s)", u.Stmt.SQL()))
+	cs.WriteKeyword(keyword)
+	if d != internal.SQLite {
+		cs.WriteValue(fmt.Sprintf("(%s)", stmt.SQL()))
+		return cs, nil
+	}
+	if hasOrderingClause(stmt) {
+		cs.WriteValue(fmt.Sprintf("SELECT * FROM (%s)", stmt.SQL()))
+		return cs, nil
+	}
+	cs.WriteValue(stmt.SQL())
 	return cs, nil
 }
+
+// hasOrderingClause reports whether the statement has ORDER BY, LIMIT or OFFSET clause.
+func hasOrderingClause(stmt interfaces.Stmt) bool {
+	for _, e := range stmt.Clauses() {
+		switch e.(type) {
+		case *OrderBy, *Limit, *Offset:
+			return true
+		}
+	}
+	return false
+}
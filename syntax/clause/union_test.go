@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/internal"
 	"github.com/champon1020/gsorm/syntax"
 	"github.com/champon1020/gsorm/syntax/clause"
 	"github.com/google/go-cmp/cmp"
@@ -57,3 +58,45 @@ func TestUnion_Build(t *testing.T) {
 		}
 	}
 }
+
+func TestUnion_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		Union   *clause.Union
+		Dialect internal.Dialect
+		Result  *syntax.ClauseSet
+	}{
+		{
+			&clause.Union{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{Keyword: "UNION", Value: "(SELECT * FROM table)"},
+		},
+		{
+			&clause.Union{Stmt: gsorm.Select(nil, "*").From("table")},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "UNION", Value: "SELECT * FROM table"},
+		},
+		{
+			&clause.Union{Stmt: gsorm.Select(nil, "*").From("table").Limit(1), All: true},
+			internal.SQLite,
+			&syntax.ClauseSet{Keyword: "UNION ALL", Value: "SELECT * FROM (SELECT * FROM table LIMIT 1)"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Union.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestIsSetOperation(t *testing.T) {
+	assert.Equal(t, true, clause.IsSetOperation(&clause.Union{}))
+	assert.Equal(t, true, clause.IsSetOperation(&clause.Intersect{}))
+	assert.Equal(t, true, clause.IsSetOperation(&clause.Except{}))
+	assert.Equal(t, false, clause.IsSetOperation(&clause.OrderBy{}))
+}
@@ -0,0 +1,52 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// UpdateFromExcluded is DO UPDATE SET action of ON CONFLICT clause
+// which updates the columns with the values proposed for insertion.
+type UpdateFromExcluded struct {
+	Columns []string
+}
+
+// String returns function call as string.
+func (u *UpdateFromExcluded) String() string {
+	var s string
+	for i, c := range u.Columns {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", c)
+	}
+	return fmt.Sprintf("UpdateFromExcluded(%s)", s)
+}
+
+// Build creates the structure of DO UPDATE SET action that implements interfaces.ClauseSet.
+func (u *UpdateFromExcluded) Build() (interfaces.ClauseSet, error) {
+	return u.BuildDialect(internal.PostgreSQL)
+}
+
+// BuildDialect creates the structure of DO UPDATE SET action for the dialect.
+// On MySQL, VALUES(column) is used instead of EXCLUDED.column and the keyword is omitted.
+func (u *UpdateFromExcluded) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	if !d.IsMySQL() {
+		cs.WriteKeyword("DO UPDATE SET")
+	}
+	for i, c := range u.Columns {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		if d.IsMySQL() {
+			cs.WriteValue(fmt.Sprintf("%s = VALUES(%s)", c, c))
+			continue
+		}
+		cs.WriteValue(fmt.Sprintf("%s = EXCLUDED.%s", c, c))
+	}
+	return cs, nil
+}
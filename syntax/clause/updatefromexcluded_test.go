@@ -0,0 +1,63 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateFromExcluded_String(t *testing.T) {
+	testCases := []struct {
+		UpdateFromExcluded *clause.UpdateFromExcluded
+		Result             string
+	}{
+		{
+			&clause.UpdateFromExcluded{Columns: []string{"first_name", "last_name"}},
+			`UpdateFromExcluded("first_name", "last_name")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.UpdateFromExcluded.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestUpdateFromExcluded_BuildDialect(t *testing.T) {
+	testCases := []struct {
+		UpdateFromExcluded *clause.UpdateFromExcluded
+		Dialect            internal.Dialect
+		Result             *syntax.ClauseSet
+	}{
+		{
+			&clause.UpdateFromExcluded{Columns: []string{"first_name", "last_name"}},
+			internal.PostgreSQL,
+			&syntax.ClauseSet{
+				Keyword: "DO UPDATE SET",
+				Value:   "first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name",
+			},
+		},
+		{
+			&clause.UpdateFromExcluded{Columns: []string{"first_name", "last_name"}},
+			internal.MySQL,
+			&syntax.ClauseSet{
+				Value: "first_name = VALUES(first_name), last_name = VALUES(last_name)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.UpdateFromExcluded.BuildDialect(testCase.Dialect)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
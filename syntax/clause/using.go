@@ -0,0 +1,38 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/syntax"
+)
+
+// Using is USING clause.
+type Using struct {
+	Columns []string
+}
+
+// String returns function call as string.
+func (u *Using) String() string {
+	var s string
+	for i, c := range u.Columns {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", c)
+	}
+	return fmt.Sprintf("Using(%s)", s)
+}
+
+// Build creates the structure of USING clause that implements interfaces.ClauseSet.
+func (u *Using) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{Parens: true}
+	cs.WriteKeyword("USING")
+	for i, c := range u.Columns {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		cs.WriteValue(c)
+	}
+	return cs, nil
+}
@@ -0,0 +1,58 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsing_String(t *testing.T) {
+	testCases := []struct {
+		Using  *clause.Using
+		Result string
+	}{
+		{
+			&clause.Using{Columns: []string{"emp_no"}},
+			`Using("emp_no")`,
+		},
+		{
+			&clause.Using{Columns: []string{"emp_no", "dept_no"}},
+			`Using("emp_no", "dept_no")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		res := testCase.Using.String()
+		assert.Equal(t, testCase.Result, res)
+	}
+}
+
+func TestUsing_Build(t *testing.T) {
+	testCases := []struct {
+		Using  *clause.Using
+		Result *syntax.ClauseSet
+	}{
+		{
+			&clause.Using{Columns: []string{"emp_no"}},
+			&syntax.ClauseSet{Keyword: "USING", Value: "emp_no", Parens: true},
+		},
+		{
+			&clause.Using{Columns: []string{"emp_no", "dept_no"}},
+			&syntax.ClauseSet{Keyword: "USING", Value: "emp_no, dept_no", Parens: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.Using.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
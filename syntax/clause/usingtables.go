@@ -0,0 +1,54 @@
+package clause
+
+import (
+	"fmt"
+
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"golang.org/x/xerrors"
+)
+
+// UsingTables is USING clause of DELETE statement.
+type UsingTables struct {
+	Tables []syntax.Table
+}
+
+// AddTable appends the table to UsingTables.Tables.
+func (u *UsingTables) AddTable(table string) {
+	t := syntax.NewTable(table)
+	u.Tables = append(u.Tables, *t)
+}
+
+// String returns function call as string.
+func (u *UsingTables) String() string {
+	var s string
+	for i, t := range u.Tables {
+		if i != 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%q", t.Build())
+	}
+	return fmt.Sprintf("Using(%s)", s)
+}
+
+// Build creates the structure of USING clause that implements interfaces.ClauseSet.
+func (u *UsingTables) Build() (interfaces.ClauseSet, error) {
+	cs := &syntax.ClauseSet{}
+	cs.WriteKeyword("USING")
+	for i, t := range u.Tables {
+		if i != 0 {
+			cs.WriteValue(",")
+		}
+		cs.WriteValue(t.Build())
+	}
+	return cs, nil
+}
+
+// BuildDialect creates the structure of USING clause for the dialect.
+func (u *UsingTables) BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error) {
+	if d != internal.PostgreSQL {
+		return nil, xerrors.Errorf("USING is not supported by %s", d)
+	}
+	return u.Build()
+}
@@ -0,0 +1,50 @@
+package clause_test
+
+import (
+	"testing"
+
+	"github.com/champon1020/gsorm/internal"
+	"github.com/champon1020/gsorm/syntax"
+	"github.com/champon1020/gsorm/syntax/clause"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsingTables_String(t *testing.T) {
+	u := new(clause.UsingTables)
+	u.AddTable("salaries")
+	u.AddTable("titles AS t")
+	assert.Equal(t, `Using("salaries", "titles AS t")`, u.String())
+}
+
+func TestUsingTables_Build(t *testing.T) {
+	testCases := []struct {
+		UsingTables *clause.UsingTables
+		Result      *syntax.ClauseSet
+	}{
+		{
+			&clause.UsingTables{Tables: []syntax.Table{{Name: "salaries"}}},
+			&syntax.ClauseSet{Keyword: "USING", Value: "salaries"},
+		},
+		{
+			&clause.UsingTables{Tables: []syntax.Table{{Name: "salaries", Alias: "s"}, {Name: "titles"}}},
+			&syntax.ClauseSet{Keyword: "USING", Value: "salaries AS s, titles"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		res, err := testCase.UsingTables.Build()
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		if diff := cmp.Diff(testCase.Result, res); diff != "" {
+			t.Errorf("Differs: (-want +got)\n%s", diff)
+		}
+	}
+}
+
+func TestUsingTables_BuildDialect_Fail(t *testing.T) {
+	_, err := (&clause.UsingTables{}).BuildDialect(internal.MySQL)
+	assert.EqualError(t, err, "USING is not supported by mysql")
+}
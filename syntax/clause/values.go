@@ -33,7 +33,11 @@ func (v *Values) Build() (interfaces.ClauseSet, error) {
 		if i != 0 {
 			cs.WriteValue(",")
 		}
-		cs.WriteValue(internal.ToString(v, nil))
+		s, err := internal.FormatValue(v, nil)
+		if err != nil {
+			return nil, err
+		}
+		cs.WriteValue(s)
 	}
 	cs.WriteValue(")")
 	return cs, nil
@@ -0,0 +1,20 @@
+package syntax
+
+import (
+	"github.com/champon1020/gsorm/interfaces"
+	"github.com/champon1020/gsorm/internal"
+)
+
+// DialectClause is implemented by the clause whose SQL depends on the dialect.
+type DialectClause interface {
+	BuildDialect(d internal.Dialect) (interfaces.ClauseSet, error)
+}
+
+// BuildClause builds the clause for the dialect.
+// If the clause doesn't implement DialectClause, Build is called instead.
+func BuildClause(e interfaces.Clause, d internal.Dialect) (interfaces.ClauseSet, error) {
+	if dc, ok := e.(DialectClause); ok {
+		return dc.BuildDialect(d)
+	}
+	return e.Build()
+}
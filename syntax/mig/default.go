@@ -22,6 +22,10 @@ func (d *Default) String() string {
 func (d *Default) Build() (interfaces.ClauseSet, error) {
 	ss := &syntax.ClauseSet{}
 	ss.WriteKeyword("DEFAULT")
-	ss.WriteValue(internal.ToString(d.Value, nil))
+	v, err := internal.FormatValue(d.Value, nil)
+	if err != nil {
+		return nil, err
+	}
+	ss.WriteValue(v)
 	return ss, nil
 }
@@ -31,3 +31,9 @@ func (r *RawClause) Build() (interfaces.ClauseSet, error) {
 	ss := &ClauseSet{Keyword: s}
 	return ss, nil
 }
+
+// HasWhere reports whether the raw clause has WHERE keyword.
+// The keyword in the quoted strings and the parentheses like subquery is ignored.
+func (r *RawClause) HasWhere() bool {
+	return hasKeyword(r.RawStr, "WHERE")
+}
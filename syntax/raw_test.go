@@ -59,3 +59,23 @@ func TestRawClause_Build_Fail(t *testing.T) {
 		t.Errorf("Error was not occurred")
 	}
 }
+
+func TestRawClause_HasWhere(t *testing.T) {
+	testCases := []struct {
+		RawStr   string
+		Expected bool
+	}{
+		{"WHERE emp_no = 1001", true},
+		{"where emp_no = 1001", true},
+		{"FORCE INDEX (idx) WHERE emp_no = 1001", true},
+		{"AND somewhere_id = 1", false},
+		{"AND name = 'WHERE'", false},
+		{"AND emp_no IN (SELECT emp_no FROM salaries WHERE salary > 60000)", false},
+		{`AND "where" = 1`, false},
+	}
+
+	for _, testCase := range testCases {
+		r := &syntax.RawClause{RawStr: testCase.RawStr}
+		assert.Equal(t, testCase.Expected, r.HasWhere(), testCase.RawStr)
+	}
+}
@@ -30,13 +30,64 @@ func buildExprWithOpt(option *buildExprOpt, expr string, vals ...interface{}) (s
 
 	values := []interface{}{}
 	for _, v := range vals {
+		if e, ok := v.(interfaces.Expr); ok {
+			expr, vals := e.Expr()
+			s, err := buildExprWithOpt(option, expr, vals...)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, s)
+			continue
+		}
 		if stmt, ok := v.(interfaces.Stmt); ok {
 			values = append(values, stmt.SQL())
 			continue
 		}
 		opt := &internal.ToStringOpt{Quotes: option.quotes}
-		values = append(values, internal.ToString(v, opt))
+		s, err := internal.FormatValue(v, opt)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, s)
 	}
 
 	return fmt.Sprintf(strings.ReplaceAll(expr, "?", "%s"), values...), nil
 }
+
+// hasKeyword reports whether the keyword appears as the word at the top level of the expression.
+// The keyword is compared case-insensitively, and the quoted strings and the parentheses are skipped.
+func hasKeyword(expr, keyword string) bool {
+	depth := 0
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// The escaped quote like 'it''s' is skipped as two quoted strings.
+			j := strings.IndexByte(expr[i+1:], c)
+			if j < 0 {
+				return false
+			}
+			i += j + 1
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+		case isWordByte(c):
+			j := i
+			for j < len(expr) && isWordByte(expr[j]) {
+				j++
+			}
+			if depth == 0 && strings.EqualFold(expr[i:j], keyword) {
+				return true
+			}
+			i = j - 1
+		}
+	}
+	return false
+}
+
+// isWordByte reports whether the byte is a part of the word like keyword or identifier.
+func isWordByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
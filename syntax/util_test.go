@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/champon1020/gsorm"
+	"github.com/champon1020/gsorm/expr"
 	"github.com/champon1020/gsorm/syntax"
 	"github.com/stretchr/testify/assert"
 )
@@ -56,6 +57,16 @@ func TestBuildExpr(t *testing.T) {
 				Where("lhs = ?", "rhs")},
 			`IN (SELECT * FROM table WHERE lhs = 'rhs')`,
 		},
+		{
+			"? > ?",
+			[]interface{}{expr.Sum("salary"), 100},
+			`SUM(salary) > 100`,
+		},
+		{
+			"lhs = ?",
+			[]interface{}{expr.Coalesce("nickname", expr.Value("none"))},
+			`lhs = COALESCE(nickname, 'none')`,
+		},
 	}
 
 	for _, testCase := range testCases {
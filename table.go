@@ -0,0 +1,66 @@
+package gsorm
+
+import (
+	"reflect"
+
+	"github.com/champon1020/gsorm/internal"
+	"golang.org/x/xerrors"
+)
+
+// Tabler is implemented by the model which has its own table name.
+type Tabler interface {
+	TableName() string
+}
+
+// NamingStrategy derives the table name from the name of struct.
+// It's used when the model doesn't implement Tabler.
+type NamingStrategy func(name string) string
+
+// DefaultNamingStrategy converts the name of struct into pluralized snake case like "employees" or "dept_emps".
+func DefaultNamingStrategy(name string) string {
+	return internal.Pluralize(internal.SnakeCase(name))
+}
+
+// From returns the table name of the model.
+// The model is the struct, the slice of struct or the pointer of them.
+// If the model implements Tabler, TableName is used.
+// Otherwise, the name is derived by the naming strategy of the connection, which is set by WithNamingStrategy.
+// If the name cannot be derived, it returns error.
+//
+//	table, err := gsorm.From(db, &Employee{})
+func From(conn conn, model interface{}) (string, error) {
+	if table := tableNameOf(conn, model); table != "" {
+		return table, nil
+	}
+	return "", xerrors.Errorf("table name cannot be derived from %v", reflect.TypeOf(model))
+}
+
+// tableNameOf returns the table name of the model.
+// If the name cannot be derived, it returns empty string.
+func tableNameOf(conn conn, model interface{}) string {
+	if t, ok := model.(Tabler); ok {
+		return t.TableName()
+	}
+
+	typ := modelType(model)
+	if typ == nil {
+		return ""
+	}
+	if t, ok := reflect.New(typ).Interface().(Tabler); ok {
+		return t.TableName()
+	}
+	if typ.Kind() != reflect.Struct || typ.Name() == "" {
+		return ""
+	}
+	return namingStrategyOf(conn)(typ.Name())
+}
+
+// modelType returns the type of the element of the model.
+// The pointers, slices and arrays are dereferenced.
+func modelType(model interface{}) reflect.Type {
+	typ := reflect.TypeOf(model)
+	for typ != nil && (typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) {
+		typ = typ.Elem()
+	}
+	return typ
+}
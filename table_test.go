@@ -0,0 +1,204 @@
+package gsorm_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/champon1020/gsorm"
+	"gotest.tools/v3/assert"
+)
+
+type DeptEmp struct {
+	EmpNo  int
+	DeptNo string
+}
+
+type Salary struct {
+	EmpNo  int
+	Salary int
+}
+
+type Title struct {
+	EmpNo     int
+	Title     string
+	DeletedAt *time.Time `gsorm:"softdelete"`
+}
+
+type tablerEmployee struct {
+	ID        int    `gsorm:"emp_no,typ=INT"`
+	FirstName string `gsorm:"typ=VARCHAR(14)"`
+}
+
+func (e *tablerEmployee) TableName() string {
+	return "employees"
+}
+
+func TestFrom(t *testing.T) {
+	testCases := []struct {
+		Model    interface{}
+		Expected string
+	}{
+		{&tablerEmployee{}, "employees"},
+		{tablerEmployee{}, "employees"},
+		{&[]tablerEmployee{}, "employees"},
+		{DeptEmp{}, "dept_emps"},
+		{&[]*Salary{}, "salaries"},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := gsorm.From(nil, testCase.Model)
+		if err != nil {
+			t.Errorf("Error was occurred: %v", err)
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestFrom_Fail(t *testing.T) {
+	testCases := []struct {
+		Model    interface{}
+		Expected string
+	}{
+		{map[string]interface{}{}, "table name cannot be derived from map[string]interface {}"},
+		{&struct{ EmpNo int }{}, "table name cannot be derived from *struct { EmpNo int }"},
+		{nil, "table name cannot be derived from <nil>"},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := gsorm.From(nil, testCase.Model)
+		if err == nil {
+			t.Errorf("Error was not occurred")
+			continue
+		}
+		assert.Equal(t, "", actual)
+		assert.Equal(t, testCase.Expected, err.Error())
+	}
+}
+
+func TestSelectStmt_FromModel(t *testing.T) {
+	actual := gsorm.Select(nil, "emp_no").FromModel(&DeptEmp{}).Where("dept_no = ?", "d001").(*gsorm.SelectStmt).SQL()
+	assert.Equal(t, `SELECT emp_no FROM dept_emps WHERE dept_no = 'd001'`, actual)
+}
+
+func TestSelectStmt_FromModel_Fail(t *testing.T) {
+	s := gsorm.Select(nil).FromModel(&struct{ EmpNo int }{}).(*gsorm.SelectStmt)
+	_, err := s.Fingerprint()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "table name cannot be derived from *struct { EmpNo int }", err.Error())
+
+	// The statement which has the error isn't built as SQL.
+	assert.Equal(t, "table name cannot be derived from *struct { EmpNo int }", s.SQL())
+}
+
+func TestWithNamingStrategy(t *testing.T) {
+	mock := gsorm.OpenMock(gsorm.WithNamingStrategy(func(name string) string {
+		return "t_" + strings.ToLower(name)
+	}))
+
+	table, _ := gsorm.From(mock, &DeptEmp{})
+	assert.Equal(t, "t_deptemp", table)
+	table, _ = gsorm.From(mock, &tablerEmployee{})
+	assert.Equal(t, "employees", table)
+	assert.Equal(t, `SELECT * FROM t_salary`, gsorm.Select(mock).FromModel(&Salary{}).(*gsorm.SelectStmt).SQL())
+	assert.Equal(t, `INSERT INTO t_salary (emp_no, salary) VALUES (1001, 60000)`,
+		gsorm.InsertModel(mock, &Salary{EmpNo: 1001, Salary: 60000}).(*gsorm.InsertStmt).SQL())
+
+	// The naming strategy of the other connection is not affected.
+	table, _ = gsorm.From(gsorm.OpenMock(), &DeptEmp{})
+	assert.Equal(t, "dept_emps", table)
+	table, _ = gsorm.From(nil, &DeptEmp{})
+	assert.Equal(t, "dept_emps", table)
+}
+
+func TestInsertModel(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.InsertStmt
+		Expected string
+	}{
+		{
+			gsorm.InsertModel(nil, &tablerEmployee{ID: 1001, FirstName: "Taro"}).(*gsorm.InsertStmt),
+			`INSERT INTO employees (emp_no, first_name) VALUES (1001, 'Taro')`,
+		},
+		{
+			gsorm.InsertModel(nil, &[]Salary{{EmpNo: 1001, Salary: 60000}, {EmpNo: 1002, Salary: 70000}}).(*gsorm.InsertStmt),
+			`INSERT INTO salaries (emp_no, salary) VALUES (1001, 60000), (1002, 70000)`,
+		},
+		{
+			gsorm.InsertModel(nil, &Title{EmpNo: 1001, Title: "Engineer"}).(*gsorm.InsertStmt),
+			`INSERT INTO titles (emp_no, title) VALUES (1001, 'Engineer')`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestInsertModel_Fail(t *testing.T) {
+	model := map[string]interface{}{"emp_no": 1001}
+	err := gsorm.InsertModel(nil, &model).Exec()
+	if err == nil {
+		t.Errorf("Error was not occurred")
+		return
+	}
+	assert.Equal(t, "table name cannot be derived from *map[string]interface {}", err.Error())
+}
+
+func TestUpdateModel(t *testing.T) {
+	testCases := []struct {
+		Stmt     *gsorm.UpdateStmt
+		Expected string
+	}{
+		{
+			gsorm.UpdateModel(nil, &tablerEmployee{ID: 1001, FirstName: "Hanako"}).
+				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE employees SET emp_no = 1001, first_name = 'Hanako' WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.UpdateModel(nil, &Salary{EmpNo: 1001, Salary: 80000}, "salary").
+				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE salaries SET salary = 80000 WHERE emp_no = 1001`,
+		},
+		{
+			gsorm.UpdateModel(nil, &Title{EmpNo: 1001, Title: "Manager"}).
+				Where("emp_no = ?", 1001).(*gsorm.UpdateStmt),
+			`UPDATE titles SET emp_no = 1001, title = 'Manager' WHERE emp_no = 1001`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := testCase.Stmt.SQL()
+		errs := testCase.Stmt.ExportedGetErrors()
+		if len(errs) > 0 {
+			t.Errorf("Error was occurred: %+v", errs[0])
+			continue
+		}
+		assert.Equal(t, testCase.Expected, actual)
+	}
+}
+
+func TestCreateTableModel(t *testing.T) {
+	actual := gsorm.CreateTableModel(nil, &tablerEmployee{}).(*gsorm.CreateTableStmt).SQL()
+	assert.Equal(t, `CREATE TABLE employees (emp_no INT, first_name VARCHAR(14))`, actual)
+}
+
+func TestDeleteFrom(t *testing.T) {
+	table, err := gsorm.From(nil, &DeptEmp{})
+	if err != nil {
+		t.Errorf("Error was occurred: %v", err)
+		return
+	}
+	actual := gsorm.Delete(nil).From(table).Where("emp_no = ?", 1001).(*gsorm.DeleteStmt).SQL()
+	assert.Equal(t, `DELETE FROM dept_emps WHERE emp_no = 1001`, actual)
+}